@@ -21,8 +21,10 @@ import (
 	"github.com/okteto/okteto/pkg/analytics"
 	"github.com/okteto/okteto/pkg/cmd/build"
 	"github.com/okteto/okteto/pkg/cmd/login"
+	k8Client "github.com/okteto/okteto/pkg/k8s/client"
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
 	"github.com/spf13/cobra"
 )
 
@@ -49,14 +51,14 @@ func Build(ctx context.Context) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if dev.Build == nil {
+				dev.Build = &model.BuildInfo{}
+			}
 			if len(args) == 1 {
 				dev.Build.Context = args[0]
 			}
 			if file != "" {
-				dev.Build.Context = file
-			}
-			if tag != "" {
-				dev.Image = tag
+				dev.Build.Dockerfile = file
 			}
 			if target != "" {
 				dev.Build.Target = target
@@ -70,15 +72,20 @@ func Build(ctx context.Context) *cobra.Command {
 				return err
 			}
 
-			if _, err := build.Run(buildKitHost, isOktetoCluster, dev.Build.Context, dev.Build.Dockerfile, dev.Image, dev.Build.Target, noCache, buildArgs, progress); err != nil {
+			imageTag, err := getBuildImageTag(dev, tag, isOktetoCluster)
+			if err != nil {
+				return err
+			}
+
+			if _, err := build.Run(buildKitHost, isOktetoCluster, dev.Build.Context, dev.Build.Dockerfile, imageTag, dev.Build.Target, noCache, buildArgs, progress); err != nil {
 				analytics.TrackBuild(false)
 				return err
 			}
-			if dev.Image == "" {
+			if imageTag == "" {
 				log.Success("Build succeeded")
 				log.Information("Your image won't be pushed. To push your image specify the flag '-t'.")
 			} else {
-				log.Success(fmt.Sprintf("Image '%s' successfully pushed", dev.Image))
+				log.Success(fmt.Sprintf("Image '%s' successfully pushed", imageTag))
 			}
 			analytics.TrackBuild(true)
 			return nil
@@ -86,10 +93,38 @@ func Build(ctx context.Context) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&file, "file", "f", "", "name of the Dockerfile (Default is 'PATH/Dockerfile')")
-	cmd.Flags().StringVarP(&tag, "tag", "t", "", "name and optionally a tag in the 'name:tag' format (it is automatically pushed)")
+	cmd.Flags().StringVarP(&tag, "tag", "t", "", "name and optionally a tag in the 'name:tag' format (it is automatically pushed). Defaults to the Okteto registry when building in Okteto Cloud")
 	cmd.Flags().StringVarP(&target, "target", "", "", "set the target build stage to build")
 	cmd.Flags().BoolVarP(&noCache, "no-cache", "", false, "do not use cache when building the image")
 	cmd.Flags().StringVarP(&progress, "progress", "", "tty", "show plain/tty build output")
 	cmd.Flags().StringArrayVar(&buildArgs, "build-arg", nil, "set build-time variables")
 	return cmd
 }
+
+//getBuildImageTag returns the tag of the image to build. When building in Okteto Cloud and no tag is provided,
+//the image is tagged and pushed to the Okteto registry
+func getBuildImageTag(dev *model.Dev, tag string, isOktetoCluster bool) (string, error) {
+	if tag != "" {
+		return tag, nil
+	}
+
+	if !isOktetoCluster {
+		return dev.Image, nil
+	}
+
+	oktetoRegistryURL, err := okteto.GetRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	if dev.Namespace == "" {
+		_, _, namespace, err := k8Client.GetLocal()
+		if err != nil {
+			log.Debugf("failed to get the current namespace: %s", err)
+			return dev.Image, nil
+		}
+		dev.Namespace = namespace
+	}
+
+	return build.GetDevImageTag(dev, "", dev.Image, oktetoRegistryURL), nil
+}
@@ -16,6 +16,7 @@ package cmd
 import (
 	"time"
 
+	getter "github.com/hashicorp/go-getter"
 	"github.com/okteto/okteto/cmd/utils"
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/ssh"
@@ -26,14 +27,18 @@ func downloadSyncthing() error {
 	t := time.NewTicker(1 * time.Second)
 	var err error
 	for i := 0; i < 3; i++ {
-		p := &progressBar{}
+		// the progress bar would be corrupted by the debug logs
+		var p getter.ProgressTracker
+		if !log.IsVerbose() {
+			p = &progressBar{}
+		}
 		err = syncthing.Install(p)
 		if err == nil {
 			return nil
 		}
 
 		if i < 2 {
-			log.Infof("failed to download syncthing, retrying: %s", err)
+			log.Debugf("failed to download syncthing, retrying: %s", err)
 			<-t.C
 		}
 	}
@@ -0,0 +1,145 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/analytics"
+	"github.com/okteto/okteto/pkg/cmd/down"
+	"github.com/okteto/okteto/pkg/errors"
+	k8Client "github.com/okteto/okteto/pkg/k8s/client"
+	"github.com/okteto/okteto/pkg/k8s/deployments"
+	"github.com/okteto/okteto/pkg/k8s/volumes"
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/syncthing"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+//Destroy deletes a development environment created by okteto up
+func Destroy() *cobra.Command {
+	var devPath string
+	var namespace string
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "destroy",
+		Short: "Deletes a development environment created by 'okteto up', including its deployment, service, secrets and persistent volume",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.Debug("starting destroy command")
+
+			dev, err := utils.LoadDev(devPath)
+			if err != nil {
+				return err
+			}
+
+			if err := dev.UpdateNamespace(namespace); err != nil {
+				return err
+			}
+
+			destroyed, err := runDestroy(dev, yes)
+			if err != nil {
+				analytics.TrackDestroy(false)
+				return err
+			}
+
+			if destroyed {
+				log.Success("Development environment '%s' destroyed", dev.Name)
+				log.Println()
+				analytics.TrackDestroy(true)
+			}
+
+			log.Debug("completed destroy command")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the destroy command is executed")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "do not ask for confirmation")
+	return cmd
+}
+
+func runDestroy(dev *model.Dev, yes bool) (bool, error) {
+	client, _, namespace, err := k8Client.GetLocal()
+	if err != nil {
+		return false, err
+	}
+	if dev.Namespace == "" {
+		dev.Namespace = namespace
+	}
+
+	d, err := deployments.Get(dev, dev.Namespace, client)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, errors.UserError{
+				E:    fmt.Errorf("development environment '%s' not found in namespace '%s'", dev.Name, dev.Namespace),
+				Hint: "Run 'okteto down -v' to remove the persistent volume of your development environment",
+			}
+		}
+		return false, err
+	}
+
+	if !isAutoCreated(d) {
+		return false, errors.UserError{
+			E:    fmt.Errorf("deployment '%s' wasn't created by okteto, refusing to destroy it", d.Name),
+			Hint: "Run 'okteto down -v' to deactivate your development environment and remove its persistent volume",
+		}
+	}
+
+	if !yes {
+		log.Information("The deployment, service, secrets and persistent volume of '%s' will be deleted from namespace '%s'", dev.Name, dev.Namespace)
+		confirmed, err := utils.AskYesNo("Do you want to continue? [y/n]: ")
+		if err != nil {
+			return false, err
+		}
+		if !confirmed {
+			return false, nil
+		}
+	}
+
+	spinner := utils.NewSpinner("Destroying your development environment...")
+	spinner.Start()
+	defer spinner.Stop()
+
+	trList, err := deployments.GetTranslations(dev, d, client)
+	if err != nil {
+		return false, err
+	}
+
+	if err := down.Run(dev, d, trList, true, client); err != nil {
+		return false, err
+	}
+
+	if dev.PersistentVolumeEnabled() {
+		if err := volumes.Destroy(dev, client); err != nil {
+			return false, err
+		}
+	}
+
+	if err := syncthing.RemoveFolder(dev); err != nil {
+		log.Debugf("failed to delete existing syncthing folder: %s", err)
+	}
+
+	return true, nil
+}
+
+//isAutoCreated returns if the deployment was created by okteto up
+func isAutoCreated(d *appsv1.Deployment) bool {
+	_, ok := d.Annotations[model.OktetoAutoCreateAnnotation]
+	return ok
+}
@@ -30,11 +30,12 @@ import (
 func Doctor() *cobra.Command {
 	var devPath string
 	var namespace string
+	var logLines int
 	cmd := &cobra.Command{
 		Use:   "doctor",
 		Short: fmt.Sprintf("Generates a zip file with the okteto logs"),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			log.Info("starting doctor command")
+			log.Debug("starting doctor command")
 
 			if k8Client.InCluster() {
 				return errors.ErrNotInCluster
@@ -58,7 +59,7 @@ func Doctor() *cobra.Command {
 			}
 
 			ctx := context.Background()
-			filename, err := doctor.Run(ctx, dev, c)
+			filename, err := doctor.Run(ctx, dev, c, logLines)
 			if err == nil {
 				log.Information("Your doctor file is available at %s", filename)
 			}
@@ -66,7 +67,8 @@ func Doctor() *cobra.Command {
 			return err
 		},
 	}
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the up command was executing")
+	cmd.Flags().IntVarP(&logLines, "log-lines", "", 1000, "number of lines of the okteto log to include. Use 0 to include the whole file")
 	return cmd
 }
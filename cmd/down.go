@@ -14,6 +14,9 @@
 package cmd
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/okteto/okteto/cmd/utils"
 	"github.com/okteto/okteto/pkg/analytics"
 	"github.com/okteto/okteto/pkg/cmd/down"
@@ -25,6 +28,7 @@ import (
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/syncthing"
 	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
 )
 
 //Down deactivates the development environment
@@ -32,12 +36,23 @@ func Down() *cobra.Command {
 	var devPath string
 	var namespace string
 	var rm bool
+	var all bool
+	var yes bool
 
 	cmd := &cobra.Command{
 		Use:   "down",
 		Short: "Deactivates your development environment",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			log.Info("starting down command")
+			log.Debug("starting down command")
+
+			if all {
+				if rm {
+					return fmt.Errorf("'--volumes' is not supported together with '--all'")
+				}
+				err := runDownAll(namespace, yes)
+				analytics.TrackDown(err == nil)
+				return err
+			}
 
 			dev, err := utils.LoadDev(devPath)
 			if err != nil {
@@ -57,28 +72,33 @@ func Down() *cobra.Command {
 			log.Information("Run 'okteto push' to deploy your code changes to the cluster")
 
 			if rm {
-				if err := removeVolume(dev); err != nil {
+				removed, err := removeVolume(dev)
+				if err != nil {
 					analytics.TrackDownVolumes(false)
 					return err
 				}
-				log.Success("Persistent volume removed")
-				if err := syncthing.RemoveFolder(dev); err != nil {
-					log.Infof("failed to delete existing syncthing folder")
+				if removed {
+					log.Success("Persistent volume removed")
+					if err := syncthing.RemoveFolder(dev); err != nil {
+						log.Debugf("failed to delete existing syncthing folder")
+					}
 				}
-				analytics.TrackDownVolumes(true)
+				analytics.TrackDownVolumes(removed)
 			}
 
 			log.Println()
 
 			analytics.TrackDown(true)
-			log.Info("completed down command")
+			log.Debug("completed down command")
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().BoolVarP(&rm, "volumes", "v", false, "remove persistent volume")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the down command is executed")
+	cmd.Flags().BoolVarP(&all, "all", "", false, "deactivate all the development environments of the namespace")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "do not ask for confirmation when using '--all'")
 	return cmd
 }
 
@@ -113,18 +133,104 @@ func runDown(dev *model.Dev) error {
 	return nil
 }
 
-func removeVolume(dev *model.Dev) error {
+func runDownAll(namespace string, yes bool) error {
+	client, _, currentNamespace, err := k8Client.GetLocal()
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = currentNamespace
+	}
+
+	ds, err := deployments.ListDevModeOn(namespace, client)
+	if err != nil {
+		return err
+	}
+
+	devs := map[string]*model.Dev{}
+	interactive := map[string]*appsv1.Deployment{}
+	trLists := map[string]map[string]*model.Translation{}
+	for i := range ds {
+		name, isInteractive := deployments.GetTranslationName(&ds[i])
+		if _, ok := devs[name]; !ok {
+			devs[name] = &model.Dev{Name: name, Namespace: namespace}
+			trLists[name] = map[string]*model.Translation{}
+		}
+		trLists[name][ds[i].Name] = &model.Translation{Name: name, Deployment: &ds[i]}
+		if isInteractive {
+			interactive[name] = &ds[i]
+		}
+	}
+
+	if len(devs) == 0 {
+		log.Information("There are no development environments active in namespace '%s'", namespace)
+		return nil
+	}
+
+	names := []string{}
+	for name := range devs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if !yes {
+		log.Information("The following development environments will be deactivated in namespace '%s':", namespace)
+		for _, name := range names {
+			log.Println(fmt.Sprintf("    - %s", name))
+		}
+		confirmed, err := utils.AskYesNo("Do you want to continue? [y/n]: ")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, name := range names {
+		spinner := utils.NewSpinner(fmt.Sprintf("Deactivating '%s'...", name))
+		spinner.Start()
+		err := down.Run(devs[name], interactive[name], trLists[name], true, client)
+		spinner.Stop()
+		if err != nil {
+			log.Fail("Failed to deactivate '%s': %s", name, err)
+			failed++
+			continue
+		}
+		log.Success("Development environment '%s' deactivated", name)
+	}
+
+	log.Println()
+	if failed > 0 {
+		return fmt.Errorf("failed to deactivate %d out of %d development environments", failed, len(names))
+	}
+	return nil
+}
+
+func removeVolume(dev *model.Dev) (bool, error) {
 	spinner := utils.NewSpinner("Removing persistent volume...")
 	spinner.Start()
 	defer spinner.Stop()
 
 	client, _, namespace, err := k8Client.GetLocal()
 	if err != nil {
-		return err
+		return false, err
 	}
 	if dev.Namespace == "" {
 		dev.Namespace = namespace
 	}
 
-	return volumes.Destroy(dev, client)
+	// the dev pods can still be terminating, volumes.Destroy waits until they release the volume claim
+	if podName := volumes.GetForeignAttachedPod(dev, client); podName != "" {
+		spinner.Stop()
+		log.Yellow("Persistent volume '%s' is still attached to 'pod/%s', skipping its removal", dev.GetVolumeName(), podName)
+		return false, nil
+	}
+
+	if err := volumes.Destroy(dev, client); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
@@ -32,6 +32,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// exitStatusError is implemented by the errors returned when a remote command exits with a non-zero exit code
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
 //Exec executes a command on the CND container
 func Exec() *cobra.Command {
 	var devPath string
@@ -54,6 +60,10 @@ func Exec() *cobra.Command {
 			err = executeExec(ctx, dev, args)
 			analytics.TrackExec(err == nil)
 
+			if exitErr, ok := err.(exitStatusError); ok {
+				return errors.CommandError{E: err, ExitCode: exitErr.ExitStatus()}
+			}
+
 			if errors.IsNotFound(err) {
 				return errors.UserError{
 					E:    fmt.Errorf("Development environment not found in namespace %s", dev.Namespace),
@@ -71,7 +81,7 @@ func Exec() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the exec command is executed")
 
 	return cmd
@@ -83,7 +93,7 @@ func executeExec(ctx context.Context, dev *model.Dev, args []string) error {
 	wrapped = append(wrapped, args...)
 
 	if dev.ExecuteOverSSHEnabled() || dev.RemoteModeEnabled() {
-		log.Infof("executing remote command over SSH")
+		log.Debugf("executing remote command over SSH")
 		return ssh.Exec(ctx, dev.RemotePort, true, os.Stdin, os.Stdout, os.Stderr, wrapped)
 	}
 
@@ -68,7 +68,7 @@ func Init() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().BoolVarP(&overwrite, "overwrite", "o", false, "overwrite existing manifest file")
 	return cmd
 }
@@ -87,7 +87,7 @@ func executeInit(devPath string, overwrite bool, language string, workDir string
 	} else {
 		l, err := linguist.ProcessDirectory(workDir)
 		if err != nil {
-			log.Info(err)
+			log.Debug(err)
 			return fmt.Errorf("Failed to determine the language of the current directory")
 		}
 
@@ -118,7 +118,7 @@ func executeInit(devPath string, overwrite bool, language string, workDir string
 		log.Debugf("getting stignore for %s", language)
 		c := linguist.GetSTIgnore(language)
 		if err := ioutil.WriteFile(stignore, c, 0600); err != nil {
-			log.Infof("failed to write stignore file: %s", err)
+			log.Debugf("failed to write stignore file: %s", err)
 		}
 	}
 
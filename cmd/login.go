@@ -82,7 +82,7 @@ to log in to a Okteto Enterprise instance running at okteto.example.com.
 				return err
 			}
 
-			log.Infof("authenticated user %s", u.ID)
+			log.Debugf("authenticated user %s", u.ID)
 
 			if oktetoURL == okteto.CloudURL {
 				log.Success("Logged in as %s", u.GithubID)
@@ -90,9 +90,9 @@ to log in to a Okteto Enterprise instance running at okteto.example.com.
 				log.Success("Logged in as %s @ %s", u.GithubID, oktetoURL)
 			}
 
-			err = namespace.RunNamespace(ctx, "")
+			err = namespace.RunNamespace(ctx, "", "")
 			if err != nil {
-				log.Infof("error fetching your Kubernetes credentials: %s", err)
+				log.Debugf("error fetching your Kubernetes credentials: %s", err)
 				log.Hint("    Run `okteto namespace` to switch your context and download your Kubernetes credentials.")
 			} else {
 				log.Hint("    Run 'okteto namespace' every time you need to activate your Okteto context again.")
@@ -112,7 +112,7 @@ to log in to a Okteto Enterprise instance running at okteto.example.com.
 func withBrowser(ctx context.Context, oktetoURL string) (*okteto.User, error) {
 	h, err := login.StartWithBrowser(ctx, oktetoURL)
 	if err != nil {
-		log.Infof("couldn't start the login process: %s", err)
+		log.Debugf("couldn't start the login process: %s", err)
 		return nil, fmt.Errorf("couldn't start the login process, please try again")
 	}
 
@@ -0,0 +1,149 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/analytics"
+	"github.com/okteto/okteto/pkg/errors"
+	k8Client "github.com/okteto/okteto/pkg/k8s/client"
+	"github.com/okteto/okteto/pkg/k8s/deployments"
+	"github.com/okteto/okteto/pkg/k8s/pods"
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/spf13/cobra"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//Logs prints the logs of the development container
+func Logs() *cobra.Command {
+	var devPath string
+	var namespace string
+	var follow bool
+	var since time.Duration
+	var tail int64
+
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Print the logs of your development environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			dev, err := utils.LoadDev(devPath)
+			if err != nil {
+				return err
+			}
+			if err := dev.UpdateNamespace(namespace); err != nil {
+				return err
+			}
+
+			opts := &apiv1.PodLogOptions{Follow: follow}
+			if since > 0 {
+				sinceSeconds := int64(since.Seconds())
+				opts.SinceSeconds = &sinceSeconds
+			}
+			if tail >= 0 {
+				opts.TailLines = &tail
+			}
+
+			err = executeLogs(ctx, dev, opts)
+			analytics.TrackLogs(err == nil)
+
+			if errors.IsNotFound(err) {
+				return errors.UserError{
+					E:    fmt.Errorf("Development environment not found in namespace %s", dev.Namespace),
+					Hint: "Run `okteto up` to launch it or use `okteto namespace` to select the correct namespace and try again",
+				}
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the logs command is executed")
+	cmd.Flags().BoolVarP(&follow, "follow", "", false, "keep streaming the logs of your development environment")
+	cmd.Flags().DurationVarP(&since, "since", "", 0, "only return logs newer than a relative duration like 5s, 2m, or 3h")
+	cmd.Flags().Int64VarP(&tail, "tail", "", -1, "number of lines to show from the end of the logs (defaults to all)")
+
+	return cmd
+}
+
+func executeLogs(ctx context.Context, dev *model.Dev, opts *apiv1.PodLogOptions) error {
+	client, _, namespace, err := k8Client.GetLocal()
+	if err != nil {
+		return err
+	}
+
+	if dev.Namespace == "" {
+		dev.Namespace = namespace
+	}
+
+	d, err := deployments.Get(dev, dev.Namespace, client)
+	if err != nil {
+		return err
+	}
+	if err := deployments.ValidateDevContainer(d, dev.Container); err != nil {
+		return err
+	}
+	dev.Container = deployments.GetDevContainer(&d.Spec.Template.Spec, dev.Container).Name
+	opts.Container = dev.Container
+
+	lastPod := ""
+	for {
+		p, err := pods.GetDevPod(ctx, dev, client, false)
+		if err != nil {
+			return err
+		}
+
+		if p == nil {
+			if lastPod == "" {
+				return errors.ErrNotFound
+			}
+		} else {
+			if lastPod != "" && lastPod != p.Name {
+				log.Information("Attaching to the new pod of your development environment '%s'", p.Name)
+				opts.SinceTime = nil
+			}
+
+			err = pods.StreamLogs(ctx, p.Name, dev.Namespace, opts, os.Stdout, client)
+			if !opts.Follow {
+				return err
+			}
+			if err != nil {
+				log.Debugf("failed to stream logs of pod %s/%s: %s", dev.Namespace, p.Name, err)
+			}
+
+			// the stream is closed when the pod is restarted. When re-attaching to the same pod, skip the logs already printed
+			lastPod = p.Name
+			now := metav1.Now()
+			opts.SinceTime = &now
+			opts.SinceSeconds = nil
+			opts.TailLines = nil
+		}
+
+		select {
+		case <-time.After(1 * time.Second):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
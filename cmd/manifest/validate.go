@@ -0,0 +1,44 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/spf13/cobra"
+)
+
+//Validate validates an okteto manifest without connecting to the cluster
+func Validate() *cobra.Command {
+	var devPath string
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: fmt.Sprintf("Validates your okteto manifest"),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dev, err := utils.LoadDev(devPath)
+			if err != nil {
+				return err
+			}
+
+			log.Success("Okteto manifest '%s' is valid", dev.Name)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&devPath, "file", "f", utils.DefaultDevManifest, "path to the manifest file, or '-' to read it from stdin")
+	return cmd
+}
@@ -50,7 +50,7 @@ func executeCreateNamespace(ctx context.Context, namespace string) error {
 	}
 	log.Success("Namespace '%s' created", oktetoNS)
 
-	if err := RunNamespace(ctx, namespace); err != nil {
+	if err := RunNamespace(ctx, namespace, ""); err != nil {
 		return err
 	}
 
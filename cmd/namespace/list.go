@@ -0,0 +1,63 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	k8Client "github.com/okteto/okteto/pkg/k8s/client"
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/cobra"
+)
+
+//List lists the namespaces of the user
+func List(ctx context.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Lists your namespaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeListNamespaces(ctx)
+		},
+	}
+}
+
+func executeListNamespaces(ctx context.Context) error {
+	spaces, err := okteto.ListNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := ""
+	if _, _, namespace, err := k8Client.GetLocal(); err == nil {
+		current = namespace
+	} else {
+		log.Debugf("couldn't get the current namespace: %s", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "CURRENT\tID\tNAME\n")
+	for _, s := range spaces {
+		mark := ""
+		if s.ID == current {
+			mark = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", mark, s.ID, s.Name)
+	}
+	return w.Flush()
+}
@@ -27,6 +27,7 @@ import (
 
 //Namespace fetch credentials for a cluster namespace
 func Namespace(ctx context.Context) *cobra.Command {
+	var contextName string
 	cmd := &cobra.Command{
 		Use:   "namespace [name]",
 		Short: "Downloads k8s credentials for a namespace",
@@ -38,16 +39,19 @@ func Namespace(ctx context.Context) *cobra.Command {
 				namespace = args[0]
 			}
 
-			err := RunNamespace(ctx, namespace)
+			err := RunNamespace(ctx, namespace, contextName)
 			analytics.TrackNamespace(err == nil)
 			return err
 		},
 	}
+	cmd.Flags().StringVarP(&contextName, "context", "c", "", "name of the kubeconfig context to create or update")
+	cmd.AddCommand(List(ctx))
+	cmd.AddCommand(Use(ctx))
 	return cmd
 }
 
 //RunNamespace starts the kubeconfig sequence
-func RunNamespace(ctx context.Context, namespace string) error {
+func RunNamespace(ctx context.Context, namespace, contextName string) error {
 	cred, err := okteto.GetCredentials(ctx, namespace)
 	if err != nil {
 		return err
@@ -58,10 +62,14 @@ func RunNamespace(ctx context.Context, namespace string) error {
 	u, _ := url.Parse(okteto.GetURL())
 	parsedHost := strings.ReplaceAll(u.Host, ".", "_")
 
-	if err := okteto.SetKubeConfig(cred, kubeConfigFile, namespace, okteto.GetUserID(), parsedHost); err != nil {
+	if contextName == "" {
+		contextName = okteto.GetContextName(namespace, parsedHost)
+	}
+
+	if err := okteto.SetKubeConfigContext(cred, kubeConfigFile, namespace, okteto.GetUserID(), parsedHost, contextName); err != nil {
 		return err
 	}
 
-	log.Success("Updated context '%s' in '%s'", parsedHost, kubeConfigFile)
+	log.Success("Updated context '%s' in '%s'", contextName, kubeConfigFile)
 	return nil
 }
@@ -0,0 +1,44 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/okteto/okteto/pkg/analytics"
+	"github.com/spf13/cobra"
+)
+
+//Use sets the active namespace
+func Use(ctx context.Context) *cobra.Command {
+	var contextName string
+	cmd := &cobra.Command{
+		Use:   "use <id>",
+		Short: "Sets the namespace used by default by okteto and kubectl",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := RunNamespace(ctx, args[0], contextName)
+			analytics.TrackNamespace(err == nil)
+			return err
+		},
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("use namespace requires one argument")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&contextName, "context", "c", "", "name of the kubeconfig context to create or update")
+	return cmd
+}
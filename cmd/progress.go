@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"github.com/cheggaaa/pb/v3"
+	"github.com/okteto/okteto/pkg/syncthing"
 )
 
 type progressBar struct {
@@ -83,3 +84,22 @@ func renderProgressBar(prefix string, current float64, scalingFactor float64) st
 	_, _ = sb.WriteString(fmt.Sprintf(" %3v%%", int(current)))
 	return sb.String()
 }
+
+// renderSyncProgress renders the progress bar of a syncthing folder followed by the bytes synchronized
+func renderSyncProgress(prefix string, c *syncthing.Completion, scalingFactor float64) string {
+	bar := renderProgressBar(prefix, c.Progress(), scalingFactor)
+	return fmt.Sprintf("%s (%s/%s)", bar, formatBytes(c.GlobalBytes-c.NeedBytes), formatBytes(c.GlobalBytes))
+}
+
+func formatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%cB", float64(b)/float64(div), "KMGTPE"[exp])
+}
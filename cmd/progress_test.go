@@ -13,7 +13,11 @@
 
 package cmd
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/okteto/okteto/pkg/syncthing"
+)
 
 func Test_renderProgressBar(t *testing.T) {
 	var tests = []struct {
@@ -59,3 +63,31 @@ func Test_renderProgressBarFuzz(t *testing.T) {
 		renderProgressBar("", i, 0.35)
 	}
 }
+
+func Test_renderSyncProgress(t *testing.T) {
+	c := &syncthing.Completion{GlobalBytes: 4 * 1024 * 1024, NeedBytes: 3 * 1024 * 1024}
+	expected := "[----->___________________]  25% (1.0MB/4.0MB)"
+	if actual := renderSyncProgress("", c, 0.25); actual != expected {
+		t.Errorf("\nexpected:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func Test_formatBytes(t *testing.T) {
+	var tests = []struct {
+		bytes    int64
+		expected string
+	}{
+		{bytes: 0, expected: "0B"},
+		{bytes: 1023, expected: "1023B"},
+		{bytes: 1536, expected: "1.5KB"},
+		{bytes: 5 * 1024 * 1024 * 1024, expected: "5.0GB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if actual := formatBytes(tt.bytes); actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
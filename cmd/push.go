@@ -49,7 +49,7 @@ func Push(ctx context.Context) *cobra.Command {
 		Use:   "push",
 		Short: "Builds, pushes and redeploys source code to the target deployment",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			log.Info("starting push command")
+			log.Debug("starting push command")
 
 			dev, err := utils.LoadDevOrDefault(devPath, deploymentName)
 			if err != nil {
@@ -95,12 +95,12 @@ func Push(ctx context.Context) *cobra.Command {
 			log.Println()
 
 			analytics.TrackPush(true, oktetoRegistryURL)
-			log.Info("completed push command")
+			log.Debug("completed push command")
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the push command is executed")
 	cmd.Flags().StringVarP(&imageTag, "tag", "t", "", "image tag to build, push and redeploy")
 	cmd.Flags().BoolVarP(&autoDeploy, "deploy", "d", false, "create deployment when it doesn't exist in a namespace")
@@ -164,7 +164,7 @@ func runPush(dev *model.Dev, autoDeploy bool, imageTag, oktetoRegistryURL, progr
 	}
 
 	imageTag = build.GetDevImageTag(dev, imageTag, imageFromDeployment, oktetoRegistryURL)
-	log.Infof("pushing with image tag %s", imageTag)
+	log.Debugf("pushing with image tag %s", imageTag)
 
 	var imageDigest string
 	buildArgs := model.SerializeBuildArgs(dev.Push.Args)
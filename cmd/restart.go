@@ -14,7 +14,11 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/deployments"
 	"github.com/okteto/okteto/pkg/k8s/pods"
 	"github.com/okteto/okteto/pkg/log"
 
@@ -32,6 +36,10 @@ func Restart() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restart",
 		Short: "Restarts the pods of your development environment",
+		Long: `Restarts the pods of your development environment and waits until the new pods are running.
+
+A running 'okteto up' session detects the restart and reconnects to the new dev pod, re-establishing the command session and the file synchronization.
+When persistent volumes are enabled, the syncthing database survives the restart, so only a quick re-scan happens when the file synchronization resumes.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			dev, err := utils.LoadDev(devPath)
 			if err != nil {
@@ -53,14 +61,14 @@ func Restart() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the exec command is executed")
 
 	return cmd
 }
 
 func executeRestart(dev *model.Dev, sn string) error {
-	log.Infof("restarting development environment")
+	log.Debugf("restarting development environment")
 	client, _, namespace, err := k8Client.GetLocal()
 	if err != nil {
 		return err
@@ -70,13 +78,21 @@ func executeRestart(dev *model.Dev, sn string) error {
 		dev.Namespace = namespace
 	}
 
+	d, err := deployments.Get(dev, dev.Namespace, client)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if d == nil || !deployments.IsDevModeOn(d) {
+		return errors.UserError{
+			E:    fmt.Errorf("Development environment '%s' is not active in namespace '%s'", dev.Name, dev.Namespace),
+			Hint: "Run 'okteto up' to activate it or use 'okteto namespace' to select the correct namespace and try again",
+		}
+	}
+
 	spinner := utils.NewSpinner("Restarting your development environment...")
 	spinner.Start()
 	defer spinner.Stop()
 
-	if err := pods.Restart(dev, client, sn); err != nil {
-		return err
-	}
-
-	return nil
+	// pods.Restart waits until the new pods are running
+	return pods.Restart(dev, client, sn)
 }
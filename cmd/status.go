@@ -29,6 +29,14 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// stalledCheckInterval is the time waited to check if an incomplete synchronization is making progress
+var stalledCheckInterval = 5 * time.Second
+
+var errSyncStalled = errors.UserError{
+	E:    fmt.Errorf("File synchronization is not making progress"),
+	Hint: "Run 'okteto status --info' to troubleshoot the synchronization service, or restart 'okteto up'",
+}
+
 //Status returns the status of the synchronization process
 func Status() *cobra.Command {
 	var devPath string
@@ -38,8 +46,11 @@ func Status() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: fmt.Sprintf("Status of the synchronization process"),
+		Long: `Status of the synchronization process.
+
+Without --watch, it exits with a non-zero code if the synchronization service is not connected, or if the synchronization is incomplete and doesn't make progress.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			log.Info("starting status command")
+			log.Debug("starting status command")
 
 			if k8Client.InCluster() {
 				return errors.ErrNotInCluster
@@ -85,7 +96,7 @@ func Status() *cobra.Command {
 			return err
 		},
 	}
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the up command is executing")
 	cmd.Flags().BoolVarP(&showInfo, "info", "i", false, "show syncthing links for troubleshooting the synchronization service")
 	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "watch for changes")
@@ -119,10 +130,54 @@ func runWithoutWatch(ctx context.Context, dev *model.Dev, sy *syncthing.Syncthin
 	if err != nil {
 		return err
 	}
+
+	connected := sy.IsConnected(ctx)
+	previous := progress
+	if connected && progress < 100 {
+		time.Sleep(stalledCheckInterval)
+		progress, err = status.Run(ctx, dev, sy)
+		if err != nil {
+			return err
+		}
+	}
+
 	if progress == 100 {
 		log.Success("Synchronization status: %.2f%%", progress)
 	} else {
 		log.Yellow("Synchronization status: %.2f%%", progress)
 	}
+
+	lastSync, err := sy.GetLastSync(ctx, dev)
+	if err != nil {
+		log.Debugf("error getting last synchronization time: %s", err)
+	} else if !lastSync.IsZero() {
+		log.Information("Last synchronization: %s", lastSync.Local().Format(time.RFC1123))
+	}
+
+	if connected {
+		log.Success("Synchronization service connected")
+	} else {
+		log.Fail("Synchronization service not connected")
+	}
+
+	for _, f := range dev.Forward {
+		if status.IsForwardActive(f) {
+			log.Success("Forward %s active", forwardDisplayLine(f))
+		} else {
+			log.Yellow("Forward %s not active", forwardDisplayLine(f))
+		}
+	}
+
+	return getSyncError(connected, previous, progress)
+}
+
+// getSyncError returns the error of a synchronization that is not connected, or that is incomplete and didn't make progress since previous
+func getSyncError(connected bool, previous, progress float64) error {
+	if !connected {
+		return errors.ErrLostSyncthing
+	}
+	if progress < 100 && progress <= previous {
+		return errSyncStalled
+	}
 	return nil
 }
@@ -0,0 +1,44 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/okteto/okteto/pkg/errors"
+)
+
+func Test_getSyncError(t *testing.T) {
+	var tests = []struct {
+		name      string
+		connected bool
+		previous  float64
+		progress  float64
+		expected  error
+	}{
+		{name: "connected", connected: true, previous: 100, progress: 100, expected: nil},
+		{name: "connected-in-progress", connected: true, previous: 40, progress: 55.5, expected: nil},
+		{name: "disconnected", connected: false, previous: 100, progress: 100, expected: errors.ErrLostSyncthing},
+		{name: "disconnected-in-progress", connected: false, previous: 40, progress: 40, expected: errors.ErrLostSyncthing},
+		{name: "stalled", connected: true, previous: 40, progress: 40, expected: errSyncStalled},
+		{name: "stalled-at-zero", connected: true, previous: 0, progress: 0, expected: errSyncStalled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSyncError(tt.connected, tt.previous, tt.progress); got != tt.expected {
+				t.Errorf("got %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
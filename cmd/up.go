@@ -17,12 +17,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/docker/pkg/term"
@@ -34,6 +36,7 @@ import (
 	k8Client "github.com/okteto/okteto/pkg/k8s/client"
 	"github.com/okteto/okteto/pkg/k8s/deployments"
 	"github.com/okteto/okteto/pkg/k8s/exec"
+	"github.com/okteto/okteto/pkg/k8s/ingresses"
 	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
 	"github.com/okteto/okteto/pkg/k8s/namespaces"
 	"github.com/okteto/okteto/pkg/k8s/pods"
@@ -58,6 +61,12 @@ import (
 // ReconnectingMessage is the message shown when we are trying to reconnect
 const ReconnectingMessage = "Trying to reconnect to your cluster. File synchronization will automatically resume when the connection improves."
 
+const (
+	maxReconnectRetries   = 10
+	initialReconnectDelay = 1 * time.Second
+	maxReconnectDelay     = 30 * time.Second
+)
+
 var (
 	localClusters = []string{"127.", "172.", "192.", "169.", "localhost", "::1", "fe80::", "fc00::"}
 )
@@ -65,23 +74,49 @@ var (
 // UpContext is the common context of all operations performed during
 // the up command
 type UpContext struct {
-	Context    context.Context
-	Cancel     context.CancelFunc
-	Dev        *model.Dev
-	Namespace  *apiv1.Namespace
-	isSwap     bool
-	retry      bool
-	Client     *kubernetes.Clientset
-	RestConfig *rest.Config
-	Pod        string
-	Forwarder  forwarder
-	Disconnect chan error
-	Running    chan error
-	Exit       chan error
-	Sy         *syncthing.Syncthing
-	ErrChan    chan error
-	cleaned    chan struct{}
-	success    bool
+	Context            context.Context
+	Cancel             context.CancelFunc
+	Dev                *model.Dev
+	Namespace          *apiv1.Namespace
+	isSwap             bool
+	retry              bool
+	Client             *kubernetes.Clientset
+	RestConfig         *rest.Config
+	Pod                string
+	Forwarder          forwarder
+	Disconnect         chan error
+	Running            chan error
+	Exit               chan error
+	Sy                 *syncthing.Syncthing
+	ErrChan            chan error
+	cleaned            chan struct{}
+	success            bool
+	state              upState
+	stateLock          sync.Mutex
+	timer              *time.Timer
+	attempts           int
+	interrupt          chan struct{}
+	initialized        bool
+	options            upOptions
+	activatedAt        time.Time
+	endpoints          []string
+	session            string
+	sessionDeployments []string
+}
+
+// upOptions are the flags of the up command that configure the activation of the development environment
+type upOptions struct {
+	autoDeploy     bool
+	build          bool
+	forcePull      bool
+	resetSyncthing bool
+	once           bool
+	showSyncGUI    bool
+	replace        bool
+	force          bool
+	noExec         bool
+	timeout        time.Duration
+	heartbeat      time.Duration
 }
 
 // Forwarder is an interface for the port-forwarding features
@@ -92,17 +127,20 @@ type forwarder interface {
 	Stop()
 }
 
-//Up starts a cloud dev environment
+// Up starts a cloud dev environment
 func Up() *cobra.Command {
 	var devPath string
 	var namespace string
 	var remote int
-	var autoDeploy bool
-	var build bool
-	var forcePull bool
-	var resetSyncthing bool
+	var command string
+	var output string
+	var restartOnExit int
+	var dryRun bool
+	var kubeContext string
+	var pullSecrets []string
+	var opts upOptions
 	cmd := &cobra.Command{
-		Use:   "up",
+		Use:   "up [-- COMMAND [args...]]",
 		Short: "Activates your development environment",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			log.Debug("starting up command")
@@ -111,24 +149,40 @@ func Up() *cobra.Command {
 				return errors.ErrNotInCluster
 			}
 
+			switch output {
+			case "":
+			case "json":
+				log.EnableJSONOutput()
+			default:
+				return fmt.Errorf("unsupported value '%s' for --output, the only supported value is 'json'", output)
+			}
+
 			u := upgradeAvailable()
 			if len(u) > 0 {
 				log.Yellow("Okteto %s is available. To upgrade:", u)
 				log.Yellow("    %s", getUpgradeCommand())
-				fmt.Println()
+				if !log.IsJSONOutput() {
+					fmt.Println()
+				}
+			}
+
+			if err := syncthing.CheckInstallPath(); err != nil {
+				return err
 			}
 
 			if syncthing.ShouldUpgrade() {
-				fmt.Println("Installing dependencies...")
+				log.Println("Installing dependencies...")
 				if err := downloadSyncthing(); err != nil {
-					log.Infof("failed to upgrade syncthing: %s", err)
+					log.Debugf("failed to upgrade syncthing: %s", err)
 
 					if !syncthing.IsInstalled() {
 						return fmt.Errorf("couldn't download syncthing, please try again")
 					}
 
 					log.Yellow("couldn't upgrade syncthing, will try again later")
-					fmt.Println()
+					if !log.IsJSONOutput() {
+						fmt.Println()
+					}
 				}
 
 				log.Success("Dependencies successfully installed")
@@ -136,6 +190,10 @@ func Up() *cobra.Command {
 
 			utils.CheckLocalWatchesConfiguration()
 
+			if kubeContext != "" {
+				k8Client.SetContext(kubeContext)
+			}
+
 			dev, err := utils.LoadDev(devPath)
 			if err != nil {
 				return err
@@ -148,27 +206,83 @@ func Up() *cobra.Command {
 				dev.RemotePort = remote
 			}
 
-			err = RunUp(dev, autoDeploy, build, forcePull, resetSyncthing)
+			if len(args) > 0 {
+				if cmd.ArgsLenAtDash() != 0 {
+					return fmt.Errorf("unexpected arguments, use 'okteto up -- <command>' to override the command of your development environment")
+				}
+				if command != "" {
+					return fmt.Errorf("'--command' and 'okteto up -- <command>' cannot be used at the same time")
+				}
+				dev.Command = args
+			}
+
+			if command != "" {
+				dev.Command = []string{dev.Shell, "-c", command}
+			}
+
+			if cmd.Flags().Changed("restart-on-exit") {
+				if restartOnExit < 0 {
+					return fmt.Errorf("'--restart-on-exit' must be >= 0")
+				}
+				dev.RestartOnExit = restartOnExit
+			}
+
+			for _, s := range pullSecrets {
+				if s == "" {
+					return fmt.Errorf("'--pull-secret' cannot be empty")
+				}
+				dev.ImagePullSecrets = append(dev.ImagePullSecrets, s)
+			}
+
+			if opts.heartbeat <= 0 {
+				return fmt.Errorf("'--heartbeat-interval' must be > 0")
+			}
+
+			if opts.noExec && (opts.once || command != "") {
+				return fmt.Errorf("'--no-exec' cannot be combined with '--once' or '--command'")
+			}
+
+			if dryRun {
+				return executeUpDryRun(dev, opts)
+			}
+
+			err = RunUp(dev, opts)
 			return err
 		},
 	}
 
-	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file")
+	cmd.Flags().StringVarP(&devPath, "file", "f", defaultManifest, "path to the manifest file, or '-' to read it from stdin")
 	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace where the up command is executed")
+	cmd.Flags().StringVarP(&kubeContext, "context", "", "", "kubeconfig context used by the up command, overrides OKTETO_K8S_CONTEXT and the current context")
 	cmd.Flags().IntVarP(&remote, "remote", "r", 0, "configures remote execution on the specified port")
-	cmd.Flags().BoolVarP(&autoDeploy, "deploy", "d", false, "create deployment when it doesn't exist in a namespace")
-	cmd.Flags().BoolVarP(&build, "build", "", false, "build on-the-fly the dev image using the info provided by the 'build' okteto manifest field")
-	cmd.Flags().BoolVarP(&forcePull, "pull", "", false, "force dev image pull")
-	cmd.Flags().BoolVarP(&resetSyncthing, "reset", "", false, "reset the file synchronization database")
+	cmd.Flags().BoolVarP(&opts.autoDeploy, "deploy", "d", false, "create deployment when it doesn't exist in a namespace")
+	cmd.Flags().BoolVarP(&opts.build, "build", "", false, "build on-the-fly the dev image using the info provided by the 'build' okteto manifest field")
+	cmd.Flags().BoolVarP(&opts.forcePull, "pull", "", false, "force dev image pull")
+	cmd.Flags().BoolVarP(&opts.resetSyncthing, "reset", "", false, "reset the file synchronization database (all your files will be transferred again)")
+	cmd.Flags().DurationVarP(&opts.timeout, "timeout", "t", 0, "maximum time to activate your development environment (e.g. 5m). Disabled by default")
+	cmd.Flags().StringVarP(&command, "command", "", "", "command to run in your development environment, overrides the 'command' field of your okteto manifest")
+	cmd.Flags().BoolVarP(&opts.once, "once", "", false, "run the command once without a TTY and exit with its exit code")
+	cmd.Flags().IntVarP(&restartOnExit, "restart-on-exit", "", 0, "number of times the command is restarted when it exits with a non-zero code, overrides the 'restartOnExit' field of your okteto manifest")
+	cmd.Flags().BoolVarP(&opts.replace, "replace", "", false, "delete and create again the deployment from its original manifest before activating your development environment")
+	cmd.Flags().BoolVarP(&opts.noExec, "no-exec", "", false, "keep file synchronization and port forwarding active without running a command in your development environment")
+	cmd.Flags().BoolVarP(&opts.force, "force", "", false, "take over the development environment even if it's in use by another 'okteto up' session")
+	cmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "print the kubernetes objects that would be created or updated without applying them")
+	cmd.Flags().BoolVarP(&opts.showSyncGUI, "show-sync-gui", "", false, "print the URLs and credentials of the file synchronization web interfaces")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "output format. Use 'json' to emit newline-delimited JSON events instead of text")
+	cmd.Flags().StringArrayVarP(&pullSecrets, "pull-secret", "", []string{}, "name of an existing secret used to pull the dev image, can be repeated. Added to the 'imagePullSecrets' field of your okteto manifest")
+	cmd.Flags().DurationVarP(&opts.heartbeat, "heartbeat-interval", "", defaultHeartbeatInterval, "interval between the connectivity checks with your development environment")
 	return cmd
 }
 
-//RunUp starts the up sequence
-func RunUp(dev *model.Dev, autoDeploy, build, forcePull, resetSyncthing bool) error {
+// RunUp starts the up sequence
+func RunUp(dev *model.Dev, opts upOptions) error {
 
 	up := &UpContext{
-		Dev:  dev,
-		Exit: make(chan error, 1),
+		Dev:       dev,
+		options:   opts,
+		session:   newSessionID(),
+		Exit:      make(chan error, 1),
+		interrupt: make(chan struct{}),
 	}
 
 	if up.Dev.ExecuteOverSSHEnabled() {
@@ -177,39 +291,53 @@ func RunUp(dev *model.Dev, autoDeploy, build, forcePull, resetSyncthing bool) er
 
 	defer up.shutdown()
 
-	if up.Dev.RemoteModeEnabled() {
-		if err := sshKeys(); err != nil {
-			return err
-		}
-
-		dev.LoadRemote(ssh.GetPublicKey())
-	}
-
-	if forcePull {
-		dev.LoadForcePull()
+	if err := up.loadDevOptions(); err != nil {
+		return err
 	}
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt)
-	go up.Activate(autoDeploy, build, resetSyncthing)
+	go up.Activate()
 	select {
 	case <-stop:
 		log.Debugf("CTRL+C received, starting shutdown sequence")
-		fmt.Println()
+		close(up.interrupt)
+		if !log.IsJSONOutput() {
+			fmt.Println()
+		}
+		log.Emit(newEvent("exited", up.Dev, up.endpoints, "interrupted"))
 	case err := <-up.Exit:
 		if err == nil {
 			log.Debugf("exit signal received, starting shutdown sequence")
+			log.Emit(newEvent("exited", up.Dev, up.endpoints, ""))
 		} else {
-			log.Infof("operation failed: %s", err)
+			log.Debugf("operation failed: %s", err)
 			up.updateStateFile(failed)
+			log.Emit(newEvent("exited", up.Dev, up.endpoints, err.Error()))
 			return err
 		}
 	}
 	return nil
 }
 
+// loadDevOptions applies the remote mode and the up flags to the development environment
+func (up *UpContext) loadDevOptions() error {
+	if up.Dev.RemoteModeEnabled() {
+		if err := sshKeys(); err != nil {
+			return err
+		}
+
+		up.Dev.LoadRemote(ssh.GetPublicKey())
+	}
+
+	if up.options.forcePull {
+		up.Dev.LoadForcePull()
+	}
+	return nil
+}
+
 // Activate activates the dev environment
-func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
+func (up *UpContext) Activate() {
 	var state *term.State
 	inFd, isTerm := term.GetFdInfo(os.Stdin)
 	if isTerm {
@@ -234,7 +362,7 @@ func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
 	}
 
 	if err := createPIDFile(up.Dev.Namespace, up.Dev.Name); err != nil {
-		log.Infof("failed to create pid file for %s - %s: %s", up.Dev.Namespace, up.Dev.Name, err)
+		log.Debugf("failed to create pid file for %s - %s: %s", up.Dev.Namespace, up.Dev.Name, err)
 		up.Exit <- fmt.Errorf("couldn't create pid file for %s - %s", up.Dev.Namespace, up.Dev.Name)
 		return
 	}
@@ -242,7 +370,7 @@ func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
 
 	up.Namespace, err = namespaces.Get(up.Dev.Namespace, up.Client)
 	if err != nil {
-		log.Infof("failed to get namespace %s: %s", up.Dev.Namespace, err)
+		log.Debugf("failed to get namespace %s: %s", up.Dev.Namespace, err)
 		up.Exit <- fmt.Errorf("couldn't get namespace/%s, please try again", up.Dev.Namespace)
 		return
 	}
@@ -258,21 +386,37 @@ func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
 		up.Running = make(chan error, 1)
 		up.ErrChan = make(chan error, 1)
 		up.cleaned = make(chan struct{}, 1)
+		up.startActivationTimer(up.options.timeout)
+		log.Emit(newEvent("activating", up.Dev, up.endpoints, ""))
 
-		d, create, err := up.getCurrentDeployment(autoDeploy)
+		d, create, err := up.getCurrentDeployment(up.options.autoDeploy)
 		if err != nil {
-			log.Infof("failed to get deployment %s/%s: %s", up.Dev.Namespace, up.Dev.Name, err)
+			log.Debugf("failed to get deployment %s/%s: %s", up.Dev.Namespace, up.Dev.Name, err)
+			up.Exit <- err
+			return
+		}
+
+		if err := up.checkDevImage(create); err != nil {
 			up.Exit <- err
 			return
 		}
 
+		if deployments.IsDevModeOn(d) {
+			if err := up.checkSession(d); err != nil {
+				up.Exit <- err
+				return
+			}
+		}
+
 		if up.retry && !deployments.IsDevModeOn(d) {
 			log.Information("Development environment has been deactivated")
 			up.Exit <- nil
 			return
 		}
 
-		if deployments.IsDevModeOn(d) && deployments.HasBeenChanged(d) {
+		// '--replace' recreates the deployment from its original manifest, so the changes are discarded
+		replace := up.options.replace && !up.retry && !create
+		if !replace && deployments.IsDevModeOn(d) && deployments.HasBeenChanged(d) {
 			up.Exit <- errors.UserError{
 				E:    fmt.Errorf("Deployment '%s' has been modified while your development environment was active", d.Name),
 				Hint: "Follow these steps:\n      1. Execute 'okteto down'\n      2. Apply your manifest changes again: 'kubectl apply'\n      3. Execute 'okteto up' again\n    More information is available here: https://okteto.com/docs/reference/known-issues/index.html#kubectl-apply-changes-are-undone-by-okteto-up",
@@ -281,9 +425,22 @@ func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
 		}
 
 		if !up.retry {
+			if err := checkForwardsAvailable(up.Dev); err != nil {
+				up.Exit <- err
+				return
+			}
+
+			if replace {
+				d, err = up.replaceDeployment(d)
+				if err != nil {
+					up.Exit <- err
+					return
+				}
+			}
+
 			analytics.TrackUp(true, up.Dev.Name, up.getClusterType(), len(up.Dev.Services) == 0, up.isSwap, up.Dev.RemoteModeEnabled())
-			if build {
-				if err := up.buildDevImage(d, create); err != nil {
+			if up.options.build {
+				if err := up.buildDevImage(d); err != nil {
 					up.Exit <- fmt.Errorf("error building dev image: %s", err)
 					return
 				}
@@ -307,41 +464,87 @@ func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
 
 		log.Success("Development environment activated")
 
-		err = up.sync(resetSyncthing && !up.retry)
+		err = up.sync()
 		if err != nil {
 			if !pods.Exists(up.Pod, up.Dev.Namespace, up.Client) {
 				log.Yellow("\nConnection lost to your development environment, reconnecting...\n")
+				up.stopActivationTimer()
 				up.shutdown()
+				if err := up.waitBeforeReconnect(); err != nil {
+					up.Exit <- err
+					return
+				}
 				continue
 			}
 			up.Exit <- err
 			return
 		}
+		up.stopActivationTimer()
 		up.success = true
+		up.attempts = 0
+		up.activatedAt = time.Now()
 		if up.retry {
 			analytics.TrackReconnect(true, up.getClusterType(), up.isSwap)
 		}
 		up.retry = true
 
 		log.Success("Files synchronized")
-		printDisplayContext(up.Dev)
+		up.endpoints, err = ingresses.GetEndpoints(up.Dev.Namespace, d.Spec.Template.Labels, up.Client)
+		if err != nil {
+			log.Debugf("failed to get the endpoints of your development environment: %s", err)
+		}
+		log.Emit(newEvent("synced", up.Dev, up.endpoints, ""))
+		printDisplayContext(up.Dev, up.endpoints)
+		if err := up.writeActiveFile(); err != nil {
+			log.Debugf("failed to write the active file: %s", err)
+		}
+		if up.options.showSyncGUI {
+			printSyncGUI(up.Sy)
+		}
+		if !log.IsJSONOutput() {
+			fmt.Println()
+		}
+		if up.Dev.ReadinessCheck == nil {
+			log.Emit(newEvent("ready", up.Dev, up.endpoints, ""))
+		}
+
+		go up.monitorConnection(up.options.heartbeat, up.checkConnectivity)
 
 		go func() {
 			<-up.cleaned
-			up.Running <- up.runCommand()
+			if err := up.runInitCommands(); err != nil {
+				up.Exit <- err
+				return
+			}
+			if up.Dev.ReadinessCheck != nil {
+				go up.waitForReadiness()
+			}
+			if up.options.noExec {
+				log.Information("Files are synchronized and ports are forwarded. Press CTRL+C to exit")
+				return
+			}
+			up.Running <- up.runCommandWithRestarts()
 		}()
 
 		prevError := up.WaitUntilExitOrInterrupt()
 		if isTerm {
 			log.Debug("Restoring terminal")
 			if err := term.RestoreTerminal(inFd, state); err != nil {
-				log.Infof("failed to restore terminal: %s", err)
+				log.Debugf("failed to restore terminal: %s", err)
 			}
 		}
 
 		if prevError != nil {
+			if pods.OOMKilled(up.Pod, up.Dev.Namespace, up.Dev.Container, up.activatedAt, up.Client) {
+				up.Exit <- errors.ErrOOMKilled
+				return
+			}
 			if up.shouldRetry(prevError) {
 				up.shutdown()
+				if err := up.waitBeforeReconnect(); err != nil {
+					up.Exit <- err
+					return
+				}
 				continue
 			}
 		}
@@ -351,16 +554,70 @@ func (up *UpContext) Activate(autoDeploy, build, resetSyncthing bool) {
 	}
 }
 
+// waitBeforeReconnect waits with an exponential backoff before the next reconnection attempt
+func (up *UpContext) waitBeforeReconnect() error {
+	up.attempts++
+	if up.attempts > maxReconnectRetries {
+		return errors.ErrLostConnection
+	}
+
+	delay := getReconnectDelay(up.attempts)
+	log.Yellow("Retrying in %s (attempt %d/%d)...", delay, up.attempts, maxReconnectRetries)
+	log.Emit(newEvent("reconnecting", up.Dev, up.endpoints, fmt.Sprintf("attempt %d/%d", up.attempts, maxReconnectRetries)))
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-up.interrupt:
+		return context.Canceled
+	}
+}
+
+func getReconnectDelay(attempt int) time.Duration {
+	delay := initialReconnectDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxReconnectDelay {
+			return maxReconnectDelay
+		}
+	}
+	return delay
+}
+
+// startActivationTimer sends an exit signal if the dev environment is not activated before the timeout expires
+func (up *UpContext) startActivationTimer(timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+
+	up.timer = time.AfterFunc(timeout, func() {
+		err := errors.UserError{
+			E:    fmt.Errorf("Timed out after %s %s", timeout, up.getState().description()),
+			Hint: "Check your development environment logs with 'kubectl describe' or increase the value of the '--timeout' flag and try again",
+		}
+		select {
+		case up.Exit <- err:
+		default:
+			log.Debugf("timeout expired but the exit signal was already sent: %s", err)
+		}
+	})
+}
+
+func (up *UpContext) stopActivationTimer() {
+	if up.timer != nil {
+		up.timer.Stop()
+	}
+}
+
 func (up *UpContext) shouldRetry(err error) bool {
 	switch err {
-	case errors.ErrLostSyncthing:
+	case errors.ErrLostSyncthing, errors.ErrLostKubernetesAPI:
 		return true
 	case errors.ErrCommandFailed:
 		if pods.Exists(up.Pod, up.Dev.Namespace, up.Client) {
 			return false
 		}
 
-		log.Infof("pod/%s was terminated, will try to reconnect", up.Pod)
+		log.Debugf("pod/%s was terminated, will try to reconnect", up.Pod)
 		return true
 	}
 
@@ -368,7 +625,7 @@ func (up *UpContext) shouldRetry(err error) bool {
 }
 
 func (up *UpContext) getCurrentDeployment(autoDeploy bool) (*appsv1.Deployment, bool, error) {
-	d, err := deployments.Get(up.Dev, up.Dev.Namespace, up.Client)
+	d, err := deployments.GetWithRetry(up.Context, up.Dev, up.Dev.Namespace, up.Client)
 	if err == nil {
 		if _, ok := d.Annotations[model.OktetoAutoCreateAnnotation]; !ok {
 			up.isSwap = true
@@ -400,18 +657,72 @@ func (up *UpContext) getCurrentDeployment(autoDeploy bool) (*appsv1.Deployment,
 	return up.Dev.GevSandbox(), true, nil
 }
 
+// replaceDeployment deletes the deployment and creates it again from its original manifest, after confirmation
+func (up *UpContext) replaceDeployment(d *appsv1.Deployment) (*appsv1.Deployment, error) {
+	original, err := up.getOriginalDeployment(d)
+	if err != nil {
+		return nil, err
+	}
+
+	replace, err := utils.AskYesNo(fmt.Sprintf("Deployment %s will be deleted and created again from its original manifest. Do you want to continue? [y/n]: ", d.Name))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read your response")
+	}
+	if !replace {
+		return nil, errors.UserError{
+			E:    fmt.Errorf("Deployment %s was not replaced", d.Name),
+			Hint: "Run 'okteto up' without the '--replace' flag",
+		}
+	}
+
+	spinner := utils.NewSpinner(fmt.Sprintf("Replacing deployment %s...", d.Name))
+	spinner.Start()
+	defer spinner.Stop()
+
+	original.Namespace = d.Namespace
+	if err := deployments.Recreate(up.Context, original, up.Client); err != nil {
+		return nil, fmt.Errorf("couldn't replace deployment %s: %s", d.Name, err)
+	}
+
+	return deployments.GetWithRetry(up.Context, up.Dev, up.Dev.Namespace, up.Client)
+}
+
+// getOriginalDeployment returns the manifest used by '--replace' to create the deployment again
+func (up *UpContext) getOriginalDeployment(d *appsv1.Deployment) (*appsv1.Deployment, error) {
+	if _, ok := d.Annotations[model.OktetoAutoCreateAnnotation]; ok {
+		return up.Dev.GevSandbox(), nil
+	}
+
+	original, err := deployments.GetOriginal(d)
+	if err != nil {
+		return nil, errors.UserError{
+			E:    err,
+			Hint: "Run 'okteto down' to restore the deployment and try again",
+		}
+	}
+	return original, nil
+}
+
 // WaitUntilExitOrInterrupt blocks execution until a stop signal is sent or a disconnect event or an error
 func (up *UpContext) WaitUntilExitOrInterrupt() error {
 	for {
 		select {
 		case err := <-up.Running:
-			fmt.Println()
+			if !log.IsJSONOutput() {
+				fmt.Println()
+			}
 			if err != nil {
-				log.Infof("command failed: %s", err)
+				log.Debugf("command failed: %s", err)
+				if uErr, ok := err.(errors.UserError); ok {
+					return uErr
+				}
+				if exitErr, ok := err.(exitStatusError); ok && up.options.once {
+					return errors.CommandError{E: err, ExitCode: exitErr.ExitStatus()}
+				}
 				return errors.ErrCommandFailed
 			}
 
-			log.Info("command completed")
+			log.Debug("command completed")
 			return nil
 
 		case err := <-up.ErrChan:
@@ -423,7 +734,22 @@ func (up *UpContext) WaitUntilExitOrInterrupt() error {
 	}
 }
 
-func (up *UpContext) buildDevImage(d *appsv1.Deployment, create bool) error {
+// checkDevImage returns an error if the deployment doesn't exist and there is no image to create it.
+// It must be called before any side effect so a failed activation doesn't leave resources behind
+func (up *UpContext) checkDevImage(create bool) error {
+	if !create || up.Dev.Image != "" {
+		return nil
+	}
+
+	// the dev image is built and pushed to the okteto registry
+	if up.options.build && namespaces.IsOktetoNamespace(up.Namespace) {
+		return nil
+	}
+
+	return fmt.Errorf("deployment '%s' doesn't exist and no value for 'image' has been provided in your okteto manifest. Set the 'image' field or deploy your application before running 'okteto up'", up.Dev.Name)
+}
+
+func (up *UpContext) buildDevImage(d *appsv1.Deployment) error {
 	oktetoRegistryURL := ""
 	if namespaces.IsOktetoNamespace(up.Namespace) {
 		var err error
@@ -433,15 +759,11 @@ func (up *UpContext) buildDevImage(d *appsv1.Deployment, create bool) error {
 		}
 	}
 
-	if oktetoRegistryURL == "" && create && up.Dev.Image == "" {
-		return fmt.Errorf("no value for 'Image' has been provided in your okteto manifest")
-	}
-
 	if up.Dev.Image == "" {
-		devContainer := deployments.GetDevContainer(&d.Spec.Template.Spec, up.Dev.Container)
-		if devContainer == nil {
-			return fmt.Errorf("container '%s' does not exist in deployment '%s'", up.Dev.Container, up.Dev.Name)
+		if err := deployments.ValidateDevContainer(d, up.Dev.Container); err != nil {
+			return err
 		}
+		devContainer := deployments.GetDevContainer(&d.Spec.Template.Spec, up.Dev.Container)
 		up.Dev.Image = devContainer.Image
 	}
 
@@ -451,18 +773,34 @@ func (up *UpContext) buildDevImage(d *appsv1.Deployment, create bool) error {
 	}
 
 	imageTag := buildCMD.GetImageTag(up.Dev.Image, up.Dev.Name, up.Dev.Namespace, oktetoRegistryURL)
-	log.Infof("building dev image tag %s", imageTag)
-
-	var imageDigest string
 	buildArgs := model.SerializeBuildArgs(up.Dev.Build.Args)
-	imageDigest, err = buildCMD.Run(buildKitHost, isOktetoCluster, up.Dev.Build.Context, up.Dev.Build.Dockerfile, imageTag, up.Dev.Build.Target, false, buildArgs, "tty")
+
+	buildHash, err := buildCMD.GetBuildHash(up.Dev.Build.Context, up.Dev.Build.Dockerfile, imageTag, up.Dev.Build.Target, buildArgs)
 	if err != nil {
-		return fmt.Errorf("error building dev image '%s': %s", imageTag, err)
+		log.Debugf("failed to calculate the build hash: %s", err)
 	}
-	if imageDigest != "" {
-		imageWithoutTag := buildCMD.GetRepoNameWithoutTag(imageTag)
-		imageTag = fmt.Sprintf("%s@%s", imageWithoutTag, imageDigest)
+
+	if previous := getLastBuild(up.Dev); buildHash != "" && previous.Hash == buildHash && previous.Image != "" {
+		log.Information("Your dev image is up to date, skipping the build")
+		imageTag = previous.Image
+	} else {
+		log.Debugf("building dev image tag %s", imageTag)
+		var imageDigest string
+		imageDigest, err = buildCMD.Run(buildKitHost, isOktetoCluster, up.Dev.Build.Context, up.Dev.Build.Dockerfile, imageTag, up.Dev.Build.Target, false, buildArgs, "tty")
+		if err != nil {
+			return fmt.Errorf("error building dev image '%s': %s", imageTag, err)
+		}
+		if imageDigest != "" {
+			imageWithoutTag := buildCMD.GetRepoNameWithoutTag(imageTag)
+			imageTag = fmt.Sprintf("%s@%s", imageWithoutTag, imageDigest)
+		}
+		if buildHash != "" {
+			if err := saveLastBuild(up.Dev, lastBuild{Hash: buildHash, Image: imageTag}); err != nil {
+				log.Debugf("failed to save the build hash: %s", err)
+			}
+		}
 	}
+
 	for _, s := range up.Dev.Services {
 		if s.Image == up.Dev.Image {
 			s.Image = imageTag
@@ -472,50 +810,83 @@ func (up *UpContext) buildDevImage(d *appsv1.Deployment, create bool) error {
 	return nil
 }
 
+// translateDevMode validates the development environment and returns the dev mode manifests of its deployments.
+// It doesn't modify the cluster, so 'okteto up --dry-run' shares it with the up sequence
+func (up *UpContext) translateDevMode(d *appsv1.Deployment) (map[string]*model.Translation, error) {
+	if err := deployments.ValidateDevContainer(d, up.Dev.Container); err != nil {
+		return nil, err
+	}
+	devContainer := deployments.GetDevContainer(&d.Spec.Template.Spec, up.Dev.Container)
+	up.Dev.Container = devContainer.Name
+	if up.Dev.Image == "" {
+		up.Dev.Image = devContainer.Image
+	}
+
+	for _, name := range up.Dev.ImagePullSecrets {
+		if _, err := secrets.Get(name, up.Dev.Namespace, up.Client); err != nil {
+			log.Debugf("failed to get image pull secret '%s': %s", name, err)
+			return nil, errors.UserError{
+				E:    fmt.Errorf("image pull secret '%s' not found in namespace '%s'", name, up.Dev.Namespace),
+				Hint: "Create it with 'kubectl create secret docker-registry' or fix the 'imagePullSecrets' field of your okteto manifest",
+			}
+		}
+	}
+
+	if err := up.checkEnvFrom(); err != nil {
+		return nil, err
+	}
+
+	trList, err := deployments.GetTranslations(up.Dev, d, up.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	gitCommit := utils.GetGitCommit(up.Dev.DevDir)
+	for _, tr := range trList {
+		tr.GitCommit = gitCommit
+		tr.Session = up.session
+	}
+
+	if err := deployments.TranslateDevMode(trList, up.Namespace, up.Client); err != nil {
+		return nil, err
+	}
+	return trList, nil
+}
+
 func (up *UpContext) devMode(d *appsv1.Deployment, create bool) error {
 	spinner := utils.NewSpinner("Activating your development environment...")
 	up.updateStateFile(activating)
 	spinner.Start()
 	defer spinner.Stop()
 
+	trList, err := up.translateDevMode(d)
+	if err != nil {
+		return err
+	}
+
 	if up.Dev.PersistentVolumeEnabled() {
 		if err := volumes.Create(up.Context, up.Dev, up.Client); err != nil {
 			return err
 		}
 	}
 
-	devContainer := deployments.GetDevContainer(&d.Spec.Template.Spec, up.Dev.Container)
-	if devContainer == nil {
-		return fmt.Errorf("Container '%s' does not exist in deployment '%s'", up.Dev.Container, up.Dev.Name)
-	}
-	up.Dev.Container = devContainer.Name
-	if up.Dev.Image == "" {
-		up.Dev.Image = devContainer.Image
-	}
-
 	up.updateStateFile(starting)
 
-	var err error
 	up.Sy, err = syncthing.New(up.Dev)
 	if err != nil {
 		return err
 	}
 
 	if err := up.Sy.Stop(true); err != nil {
-		log.Infof("failed to stop existing syncthing: %s", err)
-	}
-
-	log.Info("create deployment secrets")
-	if err := secrets.Create(up.Dev, up.Client, up.Sy); err != nil {
-		return err
+		log.Debugf("failed to stop existing syncthing: %s", err)
 	}
 
-	trList, err := deployments.GetTranslations(up.Dev, d, up.Client)
-	if err != nil {
-		return err
+	if up.options.resetSyncthing && !up.retry {
+		up.resetSyncthingHome()
 	}
 
-	if err := deployments.TranslateDevMode(trList, up.Namespace, up.Client); err != nil {
+	log.Debug("create deployment secrets")
+	if err := secrets.Create(up.Dev, up.Client, up.Sy); err != nil {
 		return err
 	}
 
@@ -529,6 +900,7 @@ func (up *UpContext) devMode(d *appsv1.Deployment, create bool) error {
 				return err
 			}
 		}
+		up.sessionDeployments = append(up.sessionDeployments, name)
 		if trList[name].Deployment.Annotations[okLabels.DeploymentAnnotation] == "" {
 			continue
 		}
@@ -578,12 +950,25 @@ func (up *UpContext) devMode(d *appsv1.Deployment, create bool) error {
 	return nil
 }
 
+// checkForwardsAvailable fails if the local port of any forward is already in use, before the deployment is modified
+func checkForwardsAvailable(dev *model.Dev) error {
+	for _, f := range dev.Forward {
+		if !model.IsPortAvailable("localhost", f.Local) {
+			return errors.UserError{
+				E:    fmt.Errorf("Local port %d is already in use by another process (forward '%s')", f.Local, forwardDisplayLine(f)),
+				Hint: "Stop the process using the port or update the 'forward' field of your okteto manifest and try again",
+			}
+		}
+	}
+	return nil
+}
+
 func (up *UpContext) forwards() error {
 	if up.Dev.ExecuteOverSSHEnabled() || up.Dev.RemoteModeEnabled() {
 		return up.sshForwards()
 	}
 
-	log.Infof("starting port forwards")
+	log.Debugf("starting port forwards")
 	up.Forwarder = forward.NewPortForwardManager(up.Context, up.RestConfig, up.Client)
 
 	for _, f := range up.Dev.Forward {
@@ -612,7 +997,7 @@ func (up *UpContext) forwards() error {
 
 	if up.Dev.RemoteModeEnabled() {
 		if err := ssh.AddEntry(up.Dev.Name, up.Dev.RemotePort); err != nil {
-			log.Infof("failed to add entry to your SSH config file: %s", err)
+			log.Debugf("failed to add entry to your SSH config file: %s", err)
 			return fmt.Errorf("failed to add entry to your SSH config file")
 		}
 
@@ -632,7 +1017,7 @@ func (up *UpContext) forwards() error {
 }
 
 func (up *UpContext) sshForwards() error {
-	log.Infof("starting SSH port forwards")
+	log.Debugf("starting SSH port forwards")
 	f := forward.NewPortForwardManager(up.Context, up.RestConfig, up.Client)
 	if err := f.Add(model.Forward{Local: up.Dev.RemotePort, Remote: up.Dev.SSHServerPort}); err != nil {
 		return err
@@ -661,22 +1046,22 @@ func (up *UpContext) sshForwards() error {
 	}
 
 	if err := ssh.AddEntry(up.Dev.Name, up.Dev.RemotePort); err != nil {
-		log.Infof("failed to add entry to your SSH config file: %s", err)
+		log.Debugf("failed to add entry to your SSH config file: %s", err)
 		return fmt.Errorf("failed to add entry to your SSH config file")
 	}
 
 	return up.Forwarder.Start(up.Pod, up.Dev.Namespace)
 }
 
-func (up *UpContext) sync(resetSyncthing bool) error {
-	if err := up.startSyncthing(resetSyncthing); err != nil {
+func (up *UpContext) sync() error {
+	if err := up.startSyncthing(); err != nil {
 		return err
 	}
 
 	return up.synchronizeFiles()
 }
 
-func (up *UpContext) startSyncthing(resetSyncthing bool) error {
+func (up *UpContext) startSyncthing() error {
 	spinner := utils.NewSpinner("Starting the file synchronization service...")
 	spinner.Start()
 	up.updateStateFile(startingSync)
@@ -706,7 +1091,7 @@ func (up *UpContext) startSyncthing(resetSyncthing bool) error {
 		}
 	}
 
-	if resetSyncthing {
+	if up.options.resetSyncthing && !up.retry {
 		spinner.Update("Resetting synchronization service database...")
 		if err := up.Sy.ResetDatabase(up.Context, up.Dev, true); err != nil {
 			return err
@@ -734,45 +1119,90 @@ func (up *UpContext) synchronizeFiles() error {
 	up.updateStateFile(synchronizing)
 	spinner.Start()
 	defer spinner.Stop()
-	reporter := make(chan float64)
+	reporter := make(chan *syncthing.Completion)
 	go func() {
 		<-time.NewTicker(2 * time.Second).C
-		var previous float64
+		previous := map[string]float64{}
 
 		for c := range reporter {
-			if c > previous {
+			progress := c.Progress()
+			if progress > previous[c.Folder] {
 				// todo: how to calculate how many characters can the line fit?
-				pb := renderProgressBar(postfix, c, pbScaling)
-				spinner.Update(pb)
-				previous = c
+				prefix := postfix
+				if c.Folder != "" {
+					prefix = fmt.Sprintf("Synchronizing %s...", filepath.Base(c.Folder))
+				}
+				spinner.Update(renderSyncProgress(prefix, c, pbScaling))
+				previous[c.Folder] = progress
 			}
 		}
 	}()
 
 	err := up.Sy.WaitForCompletion(up.Context, up.Dev, reporter)
 	if err != nil {
-		if err == errors.ErrUnknownSyncError {
-			analytics.TrackSyncError()
-			return errors.UserError{
-				E: err,
-				Hint: `Help us improve okteto by filing an issue in https://github.com/okteto/okteto/issues/new.
-    Please include the file generated by 'okteto doctor' if possible.`,
-			}
-		}
-		return err
+		return wrapSyncError(err)
 	}
 
 	// render to 100
 	spinner.Update(renderProgressBar(postfix, 100, pbScaling))
 
-	up.Sy.Type = "sendreceive"
-	up.Sy.IgnoreDelete = false
-	if err := up.Sy.UpdateConfig(); err != nil {
+	if up.Dev.Sync.StartThreshold < 100 {
+		progress, err := up.Sy.GetCompletionProgress(up.Context, up.Dev, true)
+		if err != nil {
+			log.Debugf("failed to get the synchronization progress: %s", err)
+		} else if progress < 100 {
+			spinner.Stop()
+			log.Information("%.2f%% of your files are synchronized, the rest will keep synchronizing in the background", progress)
+			go up.Sy.Monitor(up.Context, up.Disconnect, up.ErrChan)
+			go completeSynchronization(up.Context, up.Dev, up.Sy, up.Disconnect)
+			return nil
+		}
+	}
+
+	go up.Sy.Monitor(up.Context, up.Disconnect, up.ErrChan)
+	return enableBidirectionalSync(up.Context, up.Sy)
+}
+
+// completeSynchronization keeps the main folder in send-only mode, overriding the remote changes, until its initial
+// synchronization finishes. Otherwise, remote files that haven't been overwritten yet would be synchronized back
+func completeSynchronization(ctx context.Context, dev *model.Dev, sy *syncthing.Syncthing, disconnect chan error) {
+	err := sy.WaitForMainFolderCompletion(ctx, dev)
+	if err == nil {
+		log.Information("All your files are synchronized")
+		err = enableBidirectionalSync(ctx, sy)
+	}
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	log.Debugf("failed to complete the initial synchronization: %s", err)
+	select {
+	case disconnect <- wrapSyncError(err):
+	case <-ctx.Done():
+	}
+}
+
+// enableBidirectionalSync synchronizes the changes of the development container back to the local folder
+func enableBidirectionalSync(ctx context.Context, sy *syncthing.Syncthing) error {
+	sy.Type = "sendreceive"
+	sy.IgnoreDelete = false
+	if err := sy.UpdateConfig(); err != nil {
 		return err
 	}
 
-	go up.Sy.Monitor(up.Context, up.Disconnect)
-	return up.Sy.Restart(up.Context)
+	return sy.Restart(ctx)
+}
+
+func wrapSyncError(err error) error {
+	if err == errors.ErrUnknownSyncError {
+		analytics.TrackSyncError()
+		return errors.UserError{
+			E: err,
+			Hint: `Help us improve okteto by filing an issue in https://github.com/okteto/okteto/issues/new.
+    Please include the file generated by 'okteto doctor' if possible.`,
+		}
+	}
+	return err
 }
 
 func (up *UpContext) cleanCommand() {
@@ -792,11 +1222,15 @@ func (up *UpContext) cleanCommand() {
 		in,
 		&out,
 		os.Stderr,
-		[]string{"sh", "-c", cmd},
+		[]string{up.Dev.Shell, "-c", cmd},
 	)
 
 	if err != nil {
-		log.Infof("failed to clean session: %s", err)
+		log.Debugf("failed to clean session: %s", err)
+		if isMissingExecutable(err) {
+			log.Yellow("Shell '%s' not found in your development container, skipping the cleanup of previous sessions.", up.Dev.Shell)
+			log.Yellow("Set the 'shell' field of your okteto manifest if your image has a different shell.")
+		}
 	}
 
 	if utils.IsWatchesConfigurationTooLow(out.String()) {
@@ -807,27 +1241,101 @@ func (up *UpContext) cleanCommand() {
 	up.cleaned <- struct{}{}
 }
 
+// runInitCommands runs the init commands of the manifest the first time the development environment is activated
+func (up *UpContext) runInitCommands() error {
+	if up.initialized {
+		return nil
+	}
+
+	for _, c := range up.Dev.InitCommands {
+		log.Information("Running init command '%s'", c)
+		command := []string{up.Dev.Shell, "-c", c}
+		var err error
+		if up.Dev.ExecuteOverSSHEnabled() || up.Dev.RemoteModeEnabled() {
+			err = ssh.Exec(up.Context, up.Dev.RemotePort, false, strings.NewReader(""), getCommandOutput(), os.Stderr, command)
+		} else {
+			err = exec.Exec(up.Context, up.Client, up.RestConfig, up.Dev.Namespace, up.Pod, up.Dev.Container, false, strings.NewReader(""), getCommandOutput(), os.Stderr, command)
+		}
+
+		if err != nil {
+			log.Debugf("init command '%s' failed: %s", c, err)
+			if isMissingExecutable(err) {
+				return errors.UserError{
+					E:    fmt.Errorf("init command '%s' failed: shell '%s' not found in your development container", c, up.Dev.Shell),
+					Hint: "Set the 'shell' field of your okteto manifest to a shell available in your image",
+				}
+			}
+			return fmt.Errorf("init command '%s' failed: %s", c, err)
+		}
+	}
+
+	up.initialized = true
+	return nil
+}
+
+// runCommandWithRestarts runs the command and restarts it when it exits with a non-zero code, up to 'restartOnExit' times
+func (up *UpContext) runCommandWithRestarts() error {
+	for restarts := 0; ; restarts++ {
+		err := up.runCommand()
+		if err == nil || restarts >= up.Dev.RestartOnExit || up.Context.Err() != nil {
+			return err
+		}
+
+		exitErr, ok := err.(exitStatusError)
+		if !ok {
+			return err
+		}
+
+		log.Yellow("\nCommand exited with code %d, restarting it (%d/%d)...\n", exitErr.ExitStatus(), restarts+1, up.Dev.RestartOnExit)
+	}
+}
+
+// getCommandOutput returns where the output of the commands executed in the development container is written.
+// The JSON output reserves stdout for the events, so the commands write to stderr instead
+func getCommandOutput() io.Writer {
+	if log.IsJSONOutput() {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
 func (up *UpContext) runCommand() error {
-	log.Infof("starting remote command")
+	log.Debugf("starting remote command")
 	up.updateStateFile(ready)
 
+	tty := !up.options.once
+	var err error
 	if up.Dev.ExecuteOverSSHEnabled() || up.Dev.RemoteModeEnabled() {
-		return ssh.Exec(up.Context, up.Dev.RemotePort, true, os.Stdin, os.Stdout, os.Stderr, up.Dev.Command)
+		err = ssh.Exec(up.Context, up.Dev.RemotePort, tty, os.Stdin, getCommandOutput(), os.Stderr, up.Dev.Command)
+	} else {
+		err = exec.Exec(
+			up.Context,
+			up.Client,
+			up.RestConfig,
+			up.Dev.Namespace,
+			up.Pod,
+			up.Dev.Container,
+			tty,
+			os.Stdin,
+			getCommandOutput(),
+			os.Stderr,
+			up.Dev.Command,
+		)
+	}
+
+	if err != nil && isMissingExecutable(err) {
+		return errors.UserError{
+			E:    fmt.Errorf("command '%s' not found in your development container", up.Dev.Command[0]),
+			Hint: "Set the 'command' field of your okteto manifest to an executable available in your image",
+		}
 	}
+	return err
+}
 
-	return exec.Exec(
-		up.Context,
-		up.Client,
-		up.RestConfig,
-		up.Dev.Namespace,
-		up.Pod,
-		up.Dev.Container,
-		true,
-		os.Stdin,
-		os.Stdout,
-		os.Stderr,
-		up.Dev.Command,
-	)
+// isMissingExecutable returns if the error was caused by an executable that doesn't exist in the development container
+func isMissingExecutable(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "executable file not found") || strings.Contains(msg, "no such file or directory")
 }
 
 func (up *UpContext) getClusterType() string {
@@ -857,27 +1365,44 @@ func (up *UpContext) shutdown() {
 		analytics.TrackUpError(true, up.isSwap)
 	}
 
+	up.stopActivationTimer()
+	up.removeActiveFile()
+
 	if up.Cancel != nil {
 		up.Cancel()
-		log.Info("sent cancellation signal")
+		log.Debug("sent cancellation signal")
 	}
 
 	if up.Sy != nil {
-		log.Infof("stopping syncthing")
+		printConflicts(up.Sy.Conflicts())
+		log.Debugf("stopping syncthing")
 		if err := up.Sy.Stop(false); err != nil {
-			log.Infof("failed to stop syncthing during shutdown: %s", err)
+			log.Debugf("failed to stop syncthing during shutdown: %s", err)
 		}
 	}
 
-	log.Infof("stopping forwarder")
+	log.Debugf("stopping forwarder")
 	if up.Forwarder != nil {
 		up.Forwarder.Stop()
 	}
 
-	log.Info("completed shutdown sequence")
+	up.releaseSession()
+
+	log.Debug("completed shutdown sequence")
+}
+
+func printConflicts(conflicts []string) {
+	if len(conflicts) == 0 {
+		return
+	}
+
+	log.Yellow("%d synchronization conflicts were detected during this session:", len(conflicts))
+	for _, c := range conflicts {
+		log.Yellow("    %s", c)
+	}
 }
 
-func printDisplayContext(dev *model.Dev) {
+func printDisplayContext(dev *model.Dev, endpoints []string) {
 	log.Println(fmt.Sprintf("    %s %s", log.BlueString("Namespace:"), dev.Namespace))
 	log.Println(fmt.Sprintf("    %s      %s", log.BlueString("Name:"), dev.Name))
 	if dev.RemoteModeEnabled() {
@@ -885,13 +1410,9 @@ func printDisplayContext(dev *model.Dev) {
 	}
 
 	if len(dev.Forward) > 0 {
-		log.Println(fmt.Sprintf("    %s   %d -> %d", log.BlueString("Forward:"), dev.Forward[0].Local, dev.Forward[0].Remote))
+		log.Println(fmt.Sprintf("    %s   %s", log.BlueString("Forward:"), forwardDisplayLine(dev.Forward[0])))
 		for i := 1; i < len(dev.Forward); i++ {
-			if dev.Forward[i].Service {
-				log.Println(fmt.Sprintf("               %d -> %s:%d", dev.Forward[i].Local, dev.Forward[i].ServiceName, dev.Forward[i].Remote))
-				continue
-			}
-			log.Println(fmt.Sprintf("               %d -> %d", dev.Forward[i].Local, dev.Forward[i].Remote))
+			log.Println(fmt.Sprintf("               %s", forwardDisplayLine(dev.Forward[i])))
 		}
 	}
 
@@ -901,7 +1422,63 @@ func printDisplayContext(dev *model.Dev) {
 			log.Println(fmt.Sprintf("               %d <- %d", dev.Reverse[i].Local, dev.Reverse[i].Remote))
 		}
 	}
-	fmt.Println()
+
+	if len(endpoints) > 0 {
+		log.Println(fmt.Sprintf("    %s      %s", log.BlueString("URLs:"), endpoints[0]))
+		for i := 1; i < len(endpoints); i++ {
+			log.Println(fmt.Sprintf("               %s", endpoints[i]))
+		}
+	}
+}
+
+func printSyncGUI(sy *syncthing.Syncthing) {
+	log.Println(fmt.Sprintf("    %s  http://%s (local)", log.BlueString("Sync GUI:"), sy.GUIAddress))
+	log.Println(fmt.Sprintf("               http://%s (remote)", sy.RemoteGUIAddress))
+	log.Println(fmt.Sprintf("               user: okteto, password: %s", sy.GUIPassword))
+}
+
+// newEvent returns a status event of the development environment for the JSON output.
+// endpoints are the public endpoints of the development environment
+func newEvent(event string, dev *model.Dev, endpoints []string, message string) *log.Event {
+	e := &log.Event{
+		Event:     event,
+		Namespace: dev.Namespace,
+		Name:      dev.Name,
+		Endpoints: endpoints,
+		Message:   message,
+	}
+	forwards := append([]model.Forward{}, dev.Forward...)
+	if dev.RemoteModeEnabled() {
+		forwards = append(forwards, model.Forward{Name: "ssh", Local: dev.RemotePort, Remote: dev.SSHServerPort})
+	}
+	seen := map[string]bool{}
+	for _, f := range forwards {
+		line := forwardDisplayLine(f)
+		if !seen[line] {
+			seen[line] = true
+			e.Forwards = append(e.Forwards, line)
+		}
+	}
+	return e
+}
+
+func forwardDisplayLine(f model.Forward) string {
+	line := fmt.Sprintf("%d -> %d", f.Local, f.Remote)
+	if f.Service {
+		line = fmt.Sprintf("%d -> %s:%d", f.Local, f.ServiceName, f.Remote)
+	}
+	if f.Name != "" {
+		line = fmt.Sprintf("%s (%s)", line, f.Name)
+	}
+	return line
+}
+
+// resetSyncthingHome deletes the local database and configuration of the stopped syncthing instance
+func (up *UpContext) resetSyncthingHome() {
+	log.Yellow("Resetting the file synchronization service. All your files will be transferred again")
+	if err := up.Sy.RemoveHome(); err != nil {
+		log.Debugf("failed to delete existing syncthing folder: %s", err)
+	}
 }
 
 // createPIDFile creates a PID file to track Up state and existence
@@ -922,6 +1499,6 @@ func createPIDFile(ns, dpName string) error {
 func cleanPIDFile(ns, dpName string) {
 	filePath := filepath.Join(config.GetDeploymentHome(ns, dpName), "okteto.pid")
 	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
-		log.Infof("Unable to delete PID file at %s", filePath)
+		log.Debugf("Unable to delete PID file at %s", filePath)
 	}
 }
@@ -0,0 +1,76 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/okteto/okteto/pkg/config"
+	"github.com/okteto/okteto/pkg/log"
+)
+
+// activeInfo describes an active development environment for editors and other tools
+type activeInfo struct {
+	Namespace string   `json:"namespace"`
+	Name      string   `json:"name"`
+	Pod       string   `json:"pod"`
+	Container string   `json:"container"`
+	Forwards  []string `json:"forwards"`
+	PID       int      `json:"pid"`
+}
+
+// writeActiveFile writes the active file of the development environment.
+// It's written to a temporary file first, so readers never get a partial file
+func (up *UpContext) writeActiveFile() error {
+	info := activeInfo{
+		Namespace: up.Dev.Namespace,
+		Name:      up.Dev.Name,
+		Pod:       up.Pod,
+		Container: up.Dev.Container,
+		Forwards:  []string{},
+		PID:       os.Getpid(),
+	}
+	for _, f := range up.Dev.Forward {
+		info.Forwards = append(info.Forwards, f.String())
+	}
+
+	b, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	p := config.GetActiveFile(up.Dev.Namespace, up.Dev.Name)
+	tmp := fmt.Sprintf("%s.tmp", p)
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %s", tmp, err)
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to write %s: %s", p, err)
+	}
+
+	return nil
+}
+
+// removeActiveFile removes the active file of the development environment
+func (up *UpContext) removeActiveFile() {
+	p := config.GetActiveFile(up.Dev.Namespace, up.Dev.Name)
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		log.Debugf("failed to delete %s: %s", p, err)
+	}
+}
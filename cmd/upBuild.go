@@ -0,0 +1,61 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/okteto/okteto/pkg/config"
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+)
+
+const lastBuildFile = "build.json"
+
+// lastBuild records the hash of the last dev image built by 'okteto up --build' and the resulting image
+type lastBuild struct {
+	Hash  string `json:"hash"`
+	Image string `json:"image"`
+}
+
+func getLastBuildPath(dev *model.Dev) string {
+	return filepath.Join(config.GetDeploymentHome(dev.Namespace, dev.Name), lastBuildFile)
+}
+
+func getLastBuild(dev *model.Dev) lastBuild {
+	result := lastBuild{}
+	b, err := ioutil.ReadFile(getLastBuildPath(dev))
+	if err != nil {
+		log.Debugf("no previous build available: %s", err)
+		return result
+	}
+
+	if err := json.Unmarshal(b, &result); err != nil {
+		log.Debugf("failed to read the previous build: %s", err)
+		return lastBuild{}
+	}
+
+	return result
+}
+
+func saveLastBuild(dev *model.Dev, b lastBuild) error {
+	content, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(getLastBuildPath(dev), content, 0600)
+}
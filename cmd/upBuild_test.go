@@ -0,0 +1,47 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/model"
+)
+
+func TestLastBuild(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv("OKTETO_HOME", dir)
+	defer os.Unsetenv("OKTETO_HOME")
+
+	dev := &model.Dev{Name: "build-test", Namespace: "namespace"}
+
+	if got := getLastBuild(dev); got.Hash != "" || got.Image != "" {
+		t.Errorf("expected an empty build, got %+v", got)
+	}
+
+	expected := lastBuild{Hash: "abc", Image: "okteto.dev/api@sha256:123"}
+	if err := saveLastBuild(dev, expected); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := getLastBuild(dev); got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
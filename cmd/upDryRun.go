@@ -0,0 +1,145 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	k8Client "github.com/okteto/okteto/pkg/k8s/client"
+	"github.com/okteto/okteto/pkg/k8s/namespaces"
+	"github.com/okteto/okteto/pkg/k8s/secrets"
+	"github.com/okteto/okteto/pkg/k8s/services"
+	"github.com/okteto/okteto/pkg/k8s/volumes"
+	"github.com/okteto/okteto/pkg/model"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const dryRunSecretValue = "<generated by okteto up>"
+
+// executeUpDryRun prints the kubernetes objects that okteto up would create or update, without applying them
+func executeUpDryRun(dev *model.Dev, opts upOptions) error {
+	client, _, namespace, err := k8Client.GetLocal()
+	if err != nil {
+		return err
+	}
+
+	if dev.Namespace == "" {
+		dev.Namespace = namespace
+	}
+
+	ns, err := namespaces.Get(dev.Namespace, client)
+	if err != nil {
+		return fmt.Errorf("couldn't get namespace/%s, please try again: %s", dev.Namespace, err)
+	}
+
+	if !namespaces.IsOktetoAllowed(ns) {
+		return fmt.Errorf("'okteto up' is not allowed in this namespace")
+	}
+
+	up := &UpContext{
+		Context:   context.Background(),
+		Dev:       dev,
+		Client:    client,
+		Namespace: ns,
+		options:   opts,
+		session:   newSessionID(),
+	}
+
+	if err := up.loadDevOptions(); err != nil {
+		return err
+	}
+
+	// nothing is deployed in dry-run mode, so there is no need to confirm the creation of the deployment
+	d, create, err := up.getCurrentDeployment(true)
+	if err != nil {
+		return err
+	}
+
+	if err := up.checkDevImage(create); err != nil {
+		return err
+	}
+
+	// '--replace' creates the deployment again from its original manifest before translating it
+	if up.options.replace && !create {
+		d, err = up.getOriginalDeployment(d)
+		if err != nil {
+			return err
+		}
+	}
+
+	trList, err := up.translateDevMode(d)
+	if err != nil {
+		return err
+	}
+
+	objects := []runtime.Object{}
+	if dev.PersistentVolumeEnabled() {
+		pvc := volumes.Translate(dev)
+		pvc.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"}
+		objects = append(objects, pvc)
+	}
+
+	objects = append(objects, getDryRunSecret(dev))
+
+	names := []string{}
+	for name := range trList {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		deployment := trList[name].Deployment.DeepCopy()
+		deployment.TypeMeta = metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"}
+		objects = append(objects, deployment)
+	}
+
+	if create {
+		svc := services.Translate(dev)
+		svc.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Service"}
+		objects = append(objects, svc)
+	}
+
+	for _, o := range objects {
+		b, err := yaml.Marshal(o)
+		if err != nil {
+			return fmt.Errorf("failed to generate the manifest of your development environment: %s", err)
+		}
+		fmt.Fprintf(os.Stdout, "---\n%s", b)
+	}
+
+	return nil
+}
+
+// getDryRunSecret returns the okteto secret without its contents, which include the syncthing certificates
+func getDryRunSecret(dev *model.Dev) *apiv1.Secret {
+	s := &apiv1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: secrets.GetSecretName(dev)},
+		Type:       apiv1.SecretTypeOpaque,
+		StringData: map[string]string{
+			"config.xml": dryRunSecretValue,
+			"cert.pem":   dryRunSecretValue,
+			"key.pem":    dryRunSecretValue,
+		},
+	}
+	for _, secret := range dev.Secrets {
+		s.StringData[secret.GetKeyName()] = fmt.Sprintf("<content of %s>", secret.LocalPath)
+	}
+	return s
+}
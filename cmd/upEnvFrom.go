@@ -0,0 +1,79 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	"github.com/okteto/okteto/pkg/k8s/secrets"
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+)
+
+// checkEnvFrom verifies that the secrets and config maps referenced by the 'envFrom' field exist.
+// Empty keys don't prevent the development environment from starting, but they are reported as warnings
+func (up *UpContext) checkEnvFrom() error {
+	envFrom := append([]model.EnvFrom{}, up.Dev.EnvFrom...)
+	for _, s := range up.Dev.Services {
+		envFrom = append(envFrom, s.EnvFrom...)
+	}
+
+	for _, e := range envFrom {
+		if e.SecretRef != "" {
+			secret, err := secrets.Get(e.SecretRef, up.Dev.Namespace, up.Client)
+			if err != nil {
+				log.Debugf("failed to get envFrom secret '%s': %s", e.SecretRef, err)
+				return errors.UserError{
+					E:    fmt.Errorf("secret '%s' not found in namespace '%s'", e.SecretRef, up.Dev.Namespace),
+					Hint: "Create it or fix the 'envFrom' field of your okteto manifest",
+				}
+			}
+			empty := []string{}
+			for k, v := range secret.Data {
+				if len(v) == 0 {
+					empty = append(empty, k)
+				}
+			}
+			warnEmptyKeys("secret", e.SecretRef, empty)
+			continue
+		}
+
+		cm, err := configmaps.Get(e.ConfigMapRef, up.Dev.Namespace, up.Client)
+		if err != nil {
+			log.Debugf("failed to get envFrom config map '%s': %s", e.ConfigMapRef, err)
+			return errors.UserError{
+				E:    fmt.Errorf("config map '%s' not found in namespace '%s'", e.ConfigMapRef, up.Dev.Namespace),
+				Hint: "Create it or fix the 'envFrom' field of your okteto manifest",
+			}
+		}
+		empty := []string{}
+		for k, v := range cm.Data {
+			if v == "" {
+				empty = append(empty, k)
+			}
+		}
+		warnEmptyKeys("config map", e.ConfigMapRef, empty)
+	}
+	return nil
+}
+
+func warnEmptyKeys(kind, name string, keys []string) {
+	sort.Strings(keys)
+	for _, k := range keys {
+		log.Yellow("Key '%s' of the %s '%s' referenced by 'envFrom' is empty", k, kind, name)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/log"
+)
+
+const (
+	defaultHeartbeatInterval = 10 * time.Second
+	maxHeartbeatFailures     = 3
+)
+
+// monitorConnection runs check every interval and sends the error to up.Disconnect after maxHeartbeatFailures consecutive failures.
+// This detects a lost connection even when the command running in the development container doesn't fail
+func (up *UpContext) monitorConnection(interval time.Duration, check func() error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	failures := 0
+	for {
+		select {
+		case <-ticker.C:
+			err := check()
+			if err == nil {
+				failures = 0
+				continue
+			}
+
+			failures++
+			log.Debugf("heartbeat failed (%d/%d): %s", failures, maxHeartbeatFailures, err)
+			if failures < maxHeartbeatFailures {
+				continue
+			}
+
+			log.Debugf("heartbeat lost, sending disconnect signal: %s", err)
+			select {
+			case up.Disconnect <- err:
+			case <-up.Context.Done():
+			}
+			return
+		case <-up.Context.Done():
+			return
+		}
+	}
+}
+
+// checkConnectivity pings the kubernetes API and the local and remote syncthing
+func (up *UpContext) checkConnectivity() error {
+	if _, err := up.Client.Discovery().ServerVersion(); err != nil {
+		log.Debugf("kubernetes API ping failed: %s", err)
+		return errors.ErrLostKubernetesAPI
+	}
+
+	for _, local := range []bool{true, false} {
+		if err := up.Sy.Ping(up.Context, local); err != nil {
+			log.Debugf("syncthing local=%t ping failed: %s", local, err)
+			return errors.ErrLostSyncthing
+		}
+	}
+
+	return nil
+}
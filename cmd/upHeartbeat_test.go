@@ -0,0 +1,75 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/errors"
+)
+
+func TestHeartbeat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	up := &UpContext{Context: ctx, Disconnect: make(chan error, 1)}
+
+	calls := 0
+	check := func() error {
+		calls++
+		if calls == 2 {
+			return nil
+		}
+		return errors.ErrLostSyncthing
+	}
+
+	go up.monitorConnection(time.Millisecond, check)
+
+	select {
+	case err := <-up.Disconnect:
+		if err != errors.ErrLostSyncthing {
+			t.Errorf("expected %s, got %s", errors.ErrLostSyncthing, err)
+		}
+		if calls != maxHeartbeatFailures+2 {
+			t.Errorf("expected %d checks, got %d", maxHeartbeatFailures+2, calls)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("heartbeat didn't send the disconnect signal")
+	}
+}
+
+func TestHeartbeatCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	up := &UpContext{Context: ctx, Disconnect: make(chan error, 1)}
+
+	done := make(chan struct{})
+	go func() {
+		up.monitorConnection(time.Millisecond, func() error { return nil })
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("heartbeat didn't stop after the context was canceled")
+	}
+
+	select {
+	case err := <-up.Disconnect:
+		t.Errorf("unexpected disconnect signal: %s", err)
+	default:
+	}
+}
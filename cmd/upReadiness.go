@@ -0,0 +1,86 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/okteto/okteto/pkg/k8s/exec"
+	"github.com/okteto/okteto/pkg/log"
+)
+
+const readinessCheckInterval = 2 * time.Second
+
+// waitForReadiness polls the readiness check of the manifest until it passes or times out.
+// The ready event is emitted when the check passes
+func (up *UpContext) waitForReadiness() {
+	rc := up.Dev.ReadinessCheck
+	log.Debugf("waiting for the readiness check to pass...")
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+	timeout := time.NewTimer(rc.Timeout)
+	defer timeout.Stop()
+
+	for {
+		err := up.checkReadiness()
+		if err == nil {
+			log.Success("Your application is ready")
+			log.Emit(newEvent("ready", up.Dev, up.endpoints, ""))
+			return
+		}
+		log.Debugf("readiness check failed: %s", err)
+
+		select {
+		case <-ticker.C:
+		case <-timeout.C:
+			log.Debugf("readiness check failed after %s: %s", rc.Timeout, err)
+			log.Yellow("Your application is not ready after %s. Check the 'readinessCheck' field of your okteto manifest", rc.Timeout)
+			return
+		case <-up.Context.Done():
+			return
+		}
+	}
+}
+
+// checkReadiness runs the readiness check once
+func (up *UpContext) checkReadiness() error {
+	rc := up.Dev.ReadinessCheck
+	if rc.HTTP != nil {
+		_, err := up.Client.CoreV1().RESTClient().Get().
+			Namespace(up.Dev.Namespace).
+			Resource("pods").
+			Name(fmt.Sprintf("%s:%d", up.Pod, rc.HTTP.Port)).
+			SubResource("proxy").
+			Suffix(rc.HTTP.Path).
+			DoRaw()
+		return err
+	}
+
+	return exec.Exec(
+		up.Context,
+		up.Client,
+		up.RestConfig,
+		up.Dev.Namespace,
+		up.Pod,
+		up.Dev.Container,
+		false,
+		strings.NewReader(""),
+		ioutil.Discard,
+		ioutil.Discard,
+		rc.Command,
+	)
+}
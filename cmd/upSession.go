@@ -0,0 +1,101 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+
+	ps "github.com/mitchellh/go-ps"
+	"github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/deployments"
+	"github.com/okteto/okteto/pkg/log"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// newSessionID returns the identifier of the current okteto up session, in the form user@host/pid
+func newSessionID() string {
+	return fmt.Sprintf("%s/%d", getSessionOwner(), os.Getpid())
+}
+
+// getSessionOwner returns the user and host running okteto, in the form user@host
+func getSessionOwner() string {
+	username := "unknown"
+	if u, err := user.Current(); err == nil {
+		username = u.Username
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s@%s", username, hostname)
+}
+
+// isStaleSession returns true if the session was started by the same user and host and its process is no longer running.
+// This happens when 'okteto up' is killed before releasing the session
+func isStaleSession(session string) bool {
+	i := strings.LastIndex(session, "/")
+	if i < 0 || session[:i] != getSessionOwner() {
+		return false
+	}
+
+	pid, err := strconv.Atoi(session[i+1:])
+	if err != nil {
+		return false
+	}
+
+	process, err := ps.FindProcess(pid)
+	return err == nil && process == nil
+}
+
+// checkSession returns an error if the development environment is held by another okteto up session.
+// '--force' takes over the development environment, but only when activating it for the first time
+func (up *UpContext) checkSession(d *appsv1.Deployment) error {
+	holder := deployments.GetSession(d)
+	if holder == "" || holder == up.session {
+		return nil
+	}
+
+	if isStaleSession(holder) {
+		log.Debugf("session '%s' is no longer running, taking over the development environment", holder)
+		return nil
+	}
+
+	if up.options.force && !up.retry {
+		log.Yellow("Taking over the development environment from the session '%s'", holder)
+		return nil
+	}
+
+	return errors.UserError{
+		E:    fmt.Errorf("Deployment '%s' is already in use by the 'okteto up' session '%s'", d.Name, holder),
+		Hint: "Stop the other session first or run 'okteto up --force' to take over the development environment",
+	}
+}
+
+// releaseSession removes the session annotation from the deployments held by the current session
+func (up *UpContext) releaseSession() {
+	if up.Client == nil {
+		return
+	}
+
+	for _, name := range up.sessionDeployments {
+		if err := deployments.ReleaseSession(name, up.Dev.Namespace, up.session, up.Client); err != nil {
+			log.Debugf("failed to release the session of deployment %s/%s: %s", up.Dev.Namespace, name, err)
+		}
+	}
+	up.sessionDeployments = nil
+}
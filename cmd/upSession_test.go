@@ -0,0 +1,65 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_checkSession(t *testing.T) {
+	var tests = []struct {
+		name      string
+		holder    string
+		force     bool
+		retry     bool
+		expectErr bool
+	}{
+		{name: "no-session", holder: "", expectErr: false},
+		{name: "same-session", holder: "me@laptop/1", expectErr: false},
+		{name: "other-session", holder: "other@laptop/2", expectErr: true},
+		{name: "other-session-force", holder: "other@laptop/2", force: true, expectErr: false},
+		{name: "other-session-force-retry", holder: "other@laptop/2", force: true, retry: true, expectErr: true},
+		{name: "stale-session", holder: fmt.Sprintf("%s/999999999", getSessionOwner()), expectErr: false},
+		{name: "running-session", holder: fmt.Sprintf("%s/%d", getSessionOwner(), os.Getpid()), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "deployment",
+					Annotations: map[string]string{},
+				},
+			}
+			if tt.holder != "" {
+				d.Annotations[okLabels.SessionAnnotation] = tt.holder
+			}
+
+			up := &UpContext{session: "me@laptop/1", options: upOptions{force: tt.force}, retry: tt.retry}
+			err := up.checkSession(d)
+			if tt.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -33,18 +33,45 @@ const (
 	failed        upState = "failed"
 )
 
+func (s upState) description() string {
+	switch s {
+	case activating, starting:
+		return "activating your development environment"
+	case attaching:
+		return "attaching the persistent volume"
+	case pulling:
+		return "pulling the development image"
+	case startingSync:
+		return "starting the file synchronization service"
+	case synchronizing:
+		return "synchronizing your files"
+	default:
+		return "activating your development environment"
+	}
+}
+
+func (up *UpContext) getState() upState {
+	up.stateLock.Lock()
+	defer up.stateLock.Unlock()
+	return up.state
+}
+
 func (up *UpContext) updateStateFile(state upState) {
+	up.stateLock.Lock()
+	up.state = state
+	up.stateLock.Unlock()
+
 	if up.Dev.Namespace == "" {
-		log.Info("can't update state file, namespace is empty")
+		log.Debug("can't update state file, namespace is empty")
 	}
 
 	if up.Dev.Name == "" {
-		log.Info("can't update state file, name is empty")
+		log.Debug("can't update state file, name is empty")
 	}
 
 	s := config.GetStateFile(up.Dev.Namespace, up.Dev.Name)
 	log.Debugf("updating statefile %s: '%s'", s, state)
 	if err := ioutil.WriteFile(s, []byte(state), 0644); err != nil {
-		log.Infof("can't update state file, %s", err)
+		log.Debugf("can't update state file, %s", err)
 	}
 }
@@ -14,16 +14,24 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/okteto/okteto/pkg/config"
 	"github.com/okteto/okteto/pkg/errors"
+	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
+	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestWaitUntilExitOrInterrupt(t *testing.T) {
@@ -53,10 +61,81 @@ func TestWaitUntilExitOrInterrupt(t *testing.T) {
 	}
 }
 
+type fakeExitError struct {
+	code int
+}
+
+func (e fakeExitError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.code)
+}
+
+func (e fakeExitError) ExitStatus() int {
+	return e.code
+}
+
+func TestWaitUntilExitOrInterruptOnce(t *testing.T) {
+	up := UpContext{options: upOptions{once: true}}
+	up.Running = make(chan error, 1)
+	up.Running <- fakeExitError{code: 3}
+	err := up.WaitUntilExitOrInterrupt()
+	cErr, ok := err.(errors.CommandError)
+	if !ok {
+		t.Fatalf("expected a CommandError, got %v", err)
+	}
+	if cErr.ExitCode != 3 {
+		t.Errorf("expected exit code 3, got %d", cErr.ExitCode)
+	}
+
+	up.options.once = false
+	up.Running <- fakeExitError{code: 3}
+	if err := up.WaitUntilExitOrInterrupt(); err != errors.ErrCommandFailed {
+		t.Errorf("didn't translate the error: %s", err)
+	}
+}
+
+func TestWaitUntilExitOrInterruptUserError(t *testing.T) {
+	up := UpContext{}
+	up.Running = make(chan error, 1)
+	uErr := errors.UserError{E: fmt.Errorf("command 'bash' not found in your development container")}
+	up.Running <- uErr
+	if err := up.WaitUntilExitOrInterrupt(); err != uErr {
+		t.Errorf("expected %s, got %s", uErr, err)
+	}
+}
+
+func Test_isMissingExecutable(t *testing.T) {
+	var tests = []struct {
+		err      error
+		expected bool
+	}{
+		{
+			err:      fmt.Errorf(`OCI runtime exec failed: exec failed: container_linux.go:349: starting container process caused "exec: \"sh\": executable file not found in $PATH": unknown`),
+			expected: true,
+		},
+		{
+			err:      fmt.Errorf(`exec: "/bin/bash": stat /bin/bash: no such file or directory`),
+			expected: true,
+		},
+		{
+			err:      fakeExitError{code: 1},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			if got := isMissingExecutable(tt.err); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
 func Test_printDisplayContext(t *testing.T) {
 	var tests = []struct {
-		name string
-		dev  *model.Dev
+		name      string
+		dev       *model.Dev
+		endpoints []string
 	}{
 		{
 			name: "basic",
@@ -81,6 +160,14 @@ func Test_printDisplayContext(t *testing.T) {
 				Forward:   []model.Forward{{Local: 1000, Remote: 1000}, {Local: 2000, Remote: 2000}},
 			},
 		},
+		{
+			name: "named-forward",
+			dev: &model.Dev{
+				Name:      "dev",
+				Namespace: "namespace",
+				Forward:   []model.Forward{{Name: "api", Local: 1000, Remote: 1000}, {Local: 2000, Remote: 2000, Service: true, ServiceName: "db"}},
+			},
+		},
 		{
 			name: "single-reverse",
 			dev: &model.Dev{
@@ -97,16 +184,78 @@ func Test_printDisplayContext(t *testing.T) {
 				Reverse:   []model.Reverse{{Local: 1000, Remote: 1000}, {Local: 2000, Remote: 2000}},
 			},
 		},
+		{
+			name: "endpoints",
+			dev: &model.Dev{
+				Name:      "dev",
+				Namespace: "namespace",
+			},
+			endpoints: []string{"https://dev-namespace.example.com/", "https://docs-namespace.example.com/docs"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			printDisplayContext(tt.dev)
+			printDisplayContext(tt.dev, tt.endpoints)
 		})
 	}
 
 }
 
+func Test_forwardDisplayLine(t *testing.T) {
+	var tests = []struct {
+		name     string
+		forward  model.Forward
+		expected string
+	}{
+		{
+			name:     "basic",
+			forward:  model.Forward{Local: 8080, Remote: 80},
+			expected: "8080 -> 80",
+		},
+		{
+			name:     "named",
+			forward:  model.Forward{Name: "api", Local: 8080, Remote: 80},
+			expected: "8080 -> 80 (api)",
+		},
+		{
+			name:     "service",
+			forward:  model.Forward{Local: 5432, Remote: 5432, Service: true, ServiceName: "db"},
+			expected: "5432 -> db:5432",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := forwardDisplayLine(tt.forward); got != tt.expected {
+				t.Errorf("got '%s', expected '%s'", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_newEvent(t *testing.T) {
+	dev := &model.Dev{
+		Name:      "api",
+		Namespace: "cindy",
+		Forward: []model.Forward{
+			{Local: 8080, Remote: 80},
+			{Local: 5432, Remote: 5432, Service: true, ServiceName: "db"},
+		},
+	}
+
+	expected := &log.Event{
+		Event:     "ready",
+		Namespace: "cindy",
+		Name:      "api",
+		Forwards:  []string{"8080 -> 80", "5432 -> db:5432"},
+		Endpoints: []string{"https://api-cindy.cloud.okteto.net"},
+	}
+	if got := newEvent("ready", dev, []string{"https://api-cindy.cloud.okteto.net"}, ""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %+v, expected %+v", got, expected)
+	}
+}
+
 func TestCreatePIDFile(t *testing.T) {
 	deploymentName := "deployment"
 	namespace := "namespace"
@@ -133,3 +282,125 @@ func TestCreatePIDFile(t *testing.T) {
 	}
 
 }
+
+func Test_getReconnectDelay(t *testing.T) {
+	var tests = []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 1, expected: 1 * time.Second},
+		{attempt: 2, expected: 2 * time.Second},
+		{attempt: 4, expected: 8 * time.Second},
+		{attempt: 6, expected: 30 * time.Second},
+		{attempt: 10, expected: 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt-%d", tt.attempt), func(t *testing.T) {
+			if got := getReconnectDelay(tt.attempt); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWaitBeforeReconnect(t *testing.T) {
+	up := &UpContext{attempts: maxReconnectRetries}
+	if err := up.waitBeforeReconnect(); err != errors.ErrLostConnection {
+		t.Errorf("expected ErrLostConnection, got %v", err)
+	}
+
+	up = &UpContext{interrupt: make(chan struct{})}
+	close(up.interrupt)
+	if err := up.waitBeforeReconnect(); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestActiveFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv("OKTETO_HOME", dir)
+	defer os.Unsetenv("OKTETO_HOME")
+
+	up := &UpContext{
+		Dev: &model.Dev{
+			Name:      "dev",
+			Namespace: "namespace",
+			Container: "api",
+			DevDir:    dir,
+			Forward:   []model.Forward{{Local: 8080, Remote: 8080}, {Local: 5432, Remote: 5432, Service: true, ServiceName: "db"}},
+		},
+		Pod: "dev-123",
+	}
+
+	if err := up.writeActiveFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(config.GetActiveFile("namespace", "dev"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := activeInfo{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := activeInfo{
+		Namespace: "namespace",
+		Name:      "dev",
+		Pod:       "dev-123",
+		Container: "api",
+		Forwards:  []string{"8080:8080", "5432:db:5432"},
+		PID:       os.Getpid(),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+
+	up.removeActiveFile()
+	if _, err := os.Stat(config.GetActiveFile("namespace", "dev")); !os.IsNotExist(err) {
+		t.Errorf("active file was not removed: %v", err)
+	}
+}
+
+func TestCheckDevImage(t *testing.T) {
+	oktetoNamespace := &apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{okLabels.DevLabel: "true"}}}
+	namespace := &apiv1.Namespace{}
+	var tests = []struct {
+		name      string
+		image     string
+		create    bool
+		build     bool
+		namespace *apiv1.Namespace
+		expectErr bool
+	}{
+		{name: "existing-deployment", namespace: namespace},
+		{name: "create-with-image", image: "okteto/golang", create: true, namespace: namespace},
+		{name: "create-without-image", create: true, namespace: namespace, expectErr: true},
+		{name: "build-in-okteto-namespace", create: true, build: true, namespace: oktetoNamespace},
+		{name: "build-without-registry", create: true, build: true, namespace: namespace, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			up := &UpContext{
+				Dev:       &model.Dev{Name: "dev", Image: tt.image},
+				Namespace: tt.namespace,
+				options:   upOptions{build: tt.build},
+			}
+			err := up.checkDevImage(tt.create)
+			if tt.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
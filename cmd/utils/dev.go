@@ -15,6 +15,7 @@ package utils
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/log"
@@ -25,10 +26,17 @@ const (
 	//DefaultDevManifest default okteto manifest file
 	DefaultDevManifest   = "okteto.yml"
 	secondaryDevManifest = "okteto.yaml"
+
+	//StdinDevManifest reads the okteto manifest from stdin
+	StdinDevManifest = "-"
 )
 
 //LoadDev loads an okteto manifest checking "yml" and "yaml"
 func LoadDev(devPath string) (*model.Dev, error) {
+	if devPath == StdinDevManifest {
+		return loadDevFromStdin()
+	}
+
 	if !model.FileExists(devPath) {
 		if devPath == DefaultDevManifest {
 			if model.FileExists(secondaryDevManifest) {
@@ -42,6 +50,20 @@ func LoadDev(devPath string) (*model.Dev, error) {
 	return model.Get(devPath)
 }
 
+func loadDevFromStdin() (*model.Dev, error) {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read the okteto manifest from stdin: %s", err)
+	}
+	if info.Mode()&os.ModeCharDevice != 0 {
+		return nil, errors.UserError{
+			E:    fmt.Errorf("'-f -' reads the okteto manifest from stdin, but nothing was piped"),
+			Hint: "Pipe your manifest, for example 'cat okteto.yml | okteto up -f -', or pass the path of your manifest with '-f'",
+		}
+	}
+	return model.GetFromReader(os.Stdin)
+}
+
 //LoadDevOrDefault loads an okteto manifest or a default one if does not exist
 func LoadDevOrDefault(devPath, name string) (*model.Dev, error) {
 	dev, err := LoadDev(devPath)
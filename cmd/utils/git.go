@@ -0,0 +1,43 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"os/exec"
+	"strings"
+
+	"github.com/okteto/okteto/pkg/log"
+)
+
+//GetGitCommit returns the HEAD commit of the git repository of dir, with the "-dirty" suffix if tracked files have uncommitted changes.
+//It returns an empty string if dir is not a git repository or git is not installed
+func GetGitCommit(dir string) string {
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "--verify", "HEAD").Output()
+	if err != nil {
+		log.Debugf("failed to get the git commit of '%s': %s", dir, err)
+		return ""
+	}
+	commit := strings.TrimSpace(string(out))
+
+	status, err := exec.Command("git", "-C", dir, "status", "--porcelain", "--untracked-files=no").Output()
+	if err != nil {
+		log.Debugf("failed to get the git status of '%s': %s", dir, err)
+		return commit
+	}
+
+	if strings.TrimSpace(string(status)) != "" {
+		commit = commit + "-dirty"
+	}
+	return commit
+}
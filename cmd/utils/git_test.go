@@ -0,0 +1,83 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func git(t *testing.T, dir string, args ...string) {
+	args = append([]string{"-C", dir, "-c", "user.name=okteto", "-c", "user.email=test@okteto.com"}, args...)
+	if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %s: %s", args, err, out)
+	}
+}
+
+func TestGetGitCommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if commit := GetGitCommit(dir); commit != "" {
+		t.Errorf("expected an empty commit outside of a git repository, got '%s'", commit)
+	}
+
+	git(t, dir, "init")
+	if commit := GetGitCommit(dir); commit != "" {
+		t.Errorf("expected an empty commit in a repository without commits, got '%s'", commit)
+	}
+
+	file := filepath.Join(dir, "main.go")
+	if err := ioutil.WriteFile(file, []byte("package main"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	git(t, dir, "add", "main.go")
+	git(t, dir, "commit", "-m", "first commit")
+
+	sha := regexp.MustCompile(`^[0-9a-f]{40}$`)
+	commit := GetGitCommit(dir)
+	if !sha.MatchString(commit) {
+		t.Errorf("expected a clean commit, got '%s'", commit)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "untracked.go"), []byte("package main"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetGitCommit(dir); got != commit {
+		t.Errorf("untracked files shouldn't make the commit dirty, got '%s'", got)
+	}
+
+	if err := ioutil.WriteFile(file, []byte("package main\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := GetGitCommit(dir); got != commit+"-dirty" {
+		t.Errorf("expected '%s-dirty', got '%s'", commit, got)
+	}
+
+	git(t, dir, "checkout", "--detach", "HEAD")
+	if got := GetGitCommit(dir); got != commit+"-dirty" {
+		t.Errorf("expected '%s-dirty' in detached HEAD, got '%s'", commit, got)
+	}
+}
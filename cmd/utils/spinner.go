@@ -19,6 +19,7 @@ import (
 	"unicode"
 
 	sp "github.com/briandowns/spinner"
+	"github.com/okteto/okteto/pkg/log"
 )
 
 //Spinner represents an okteto spinner
@@ -38,6 +39,9 @@ func NewSpinner(suffix string) *Spinner {
 
 //Start starts the spinner
 func (p *Spinner) Start() {
+	if log.IsJSONOutput() || log.IsVerbose() {
+		return
+	}
 	p.sp.Start()
 }
 
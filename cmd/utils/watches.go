@@ -32,7 +32,7 @@ func CheckLocalWatchesConfiguration() {
 	w := "/proc/sys/fs/inotify/max_user_watches"
 	f, err := ioutil.ReadFile(w)
 	if err != nil {
-		log.Infof("Fail to read %s: %s", w, err)
+		log.Debugf("Fail to read %s: %s", w, err)
 		return
 	}
 
@@ -49,7 +49,7 @@ func IsWatchesConfigurationTooLow(value string) bool {
 	value = strings.TrimSuffix(string(value), "\n")
 	c, err := strconv.Atoi(value)
 	if err != nil {
-		log.Infof("Fail to parse the value of max_user_watches: %s", err)
+		log.Debugf("Fail to parse the value of max_user_watches: %s", err)
 		return false
 	}
 	log.Debugf("max_user_watches = %d", c)
@@ -17,35 +17,70 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/google/go-github/v28/github"
 	"github.com/okteto/okteto/pkg/config"
+	"github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/spf13/cobra"
 )
 
 //Version returns information about the binary
 func Version() *cobra.Command {
-	return &cobra.Command{
+	var check bool
+	var timeout time.Duration
+	cmd := &cobra.Command{
 		Use:   "version",
 		Short: fmt.Sprintf("View the version of the okteto binary"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			fmt.Printf("okteto version %s \n", config.VersionString)
+			if check {
+				return checkVersion(timeout)
+			}
 			return nil
 		},
 	}
+	cmd.Flags().BoolVarP(&check, "check", "", false, "check if a new version is available. Exits with a non-zero code if an upgrade is available")
+	cmd.Flags().DurationVarP(&timeout, "timeout", "", 10*time.Second, "maximum time to get the latest version when using '--check'")
+	return cmd
+}
+
+func checkVersion(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	v, err := getLatestVersionFromGithub(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("latest version %s \n", v)
+	if u := getUpgradeVersion(v); len(u) > 0 {
+		log.Yellow("Okteto %s is available. To upgrade:", u)
+		log.Yellow("    %s", getUpgradeCommand())
+		return errors.CommandError{E: fmt.Errorf("okteto %s is available", u), ExitCode: 1}
+	}
+
+	log.Success("Your okteto binary is up to date")
+	return nil
 }
 
 func upgradeAvailable() string {
-	current, err := semver.NewVersion(config.VersionString)
+	v, err := GetLatestVersionFromGithub()
 	if err != nil {
+		log.Debugf("failed to get latest version from github: %s", err)
 		return ""
 	}
 
-	v, err := GetLatestVersionFromGithub()
+	return getUpgradeVersion(v)
+}
+
+// getUpgradeVersion returns the latest version if the user should be notified about it
+func getUpgradeVersion(v string) string {
+	current, err := semver.NewVersion(config.VersionString)
 	if err != nil {
-		log.Infof("failed to get latest version from github: %s", err)
 		return ""
 	}
 
@@ -54,7 +89,7 @@ func upgradeAvailable() string {
 	if len(v) > 0 {
 		latest, err := semver.NewVersion(v)
 		if err != nil {
-			log.Infof("failed to parse latest version '%s': %s", v, err)
+			log.Debugf("failed to parse latest version '%s': %s", v, err)
 			return ""
 		}
 
@@ -69,8 +104,11 @@ func upgradeAvailable() string {
 
 // GetLatestVersionFromGithub returns the latest okteto version from Github
 func GetLatestVersionFromGithub() (string, error) {
+	return getLatestVersionFromGithub(context.Background())
+}
+
+func getLatestVersionFromGithub(ctx context.Context) (string, error) {
 	client := github.NewClient(nil)
-	ctx := context.Background()
 	releases, _, err := client.Repositories.ListReleases(ctx, "okteto", "okteto", &github.ListOptions{PerPage: 5})
 	if err != nil {
 		return "", fmt.Errorf("fail to get releases from github: %s", err)
@@ -17,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/Masterminds/semver"
+	"github.com/okteto/okteto/pkg/config"
 )
 
 func Test_shouldNotify(t *testing.T) {
@@ -47,3 +48,28 @@ func Test_shouldNotify(t *testing.T) {
 		})
 	}
 }
+
+func Test_getUpgradeVersion(t *testing.T) {
+	current := config.VersionString
+	defer func() { config.VersionString = current }()
+
+	tests := []struct {
+		name    string
+		current string
+		latest  string
+		want    string
+	}{
+		{name: "up-to-date", current: "1.8.0", latest: "1.8.0", want: ""},
+		{name: "minor", current: "1.8.0", latest: "1.9.0", want: "1.9.0"},
+		{name: "invalid-current", current: "dev", latest: "1.9.0", want: ""},
+		{name: "invalid-latest", current: "1.8.0", latest: "latest", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.VersionString = tt.current
+			if got := getUpgradeVersion(tt.latest); got != tt.want {
+				t.Errorf("getUpgradeVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -77,6 +77,7 @@ require (
 	k8s.io/client-go v0.17.2
 	k8s.io/kubectl v0.17.2
 	rsc.io/letsencrypt v0.0.3 // indirect
+	sigs.k8s.io/yaml v1.1.0
 )
 
 replace (
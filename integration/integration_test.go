@@ -217,6 +217,12 @@ func TestAll(t *testing.T) {
 		contentPath := filepath.Join(dir, "index.html")
 		ioutil.WriteFile(contentPath, []byte(name), 0644)
 
+		if runtime.GOOS != "windows" {
+			if err := os.Symlink("index.html", filepath.Join(dir, "link.html")); err != nil {
+				t.Fatal(err)
+			}
+		}
+
 		manifestPath := filepath.Join(dir, "okteto.yml")
 		if err := writeManifest(manifestPath, name); err != nil {
 			t.Fatal(err)
@@ -254,6 +260,18 @@ func TestAll(t *testing.T) {
 			t.Fatalf("expected synchronized content to be %s, got %s", name, c)
 		}
 
+		if runtime.GOOS != "windows" {
+			log.Println("getting synchronized symlink")
+			c, err = getContent("http://localhost:8080/link.html", 120)
+			if err != nil {
+				t.Fatalf("failed to get symlink content: %s", err)
+			}
+
+			if c != name {
+				t.Fatalf("expected symlink content to be %s, got %s", name, c)
+			}
+		}
+
 		// Update content in token file
 		updatedContent := fmt.Sprintf("%d", time.Now().Unix())
 		ioutil.WriteFile(contentPath, []byte(updatedContent), 0644)
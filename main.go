@@ -19,8 +19,10 @@ import (
 	"os"
 
 	"github.com/okteto/okteto/cmd"
+	"github.com/okteto/okteto/cmd/manifest"
 	"github.com/okteto/okteto/cmd/namespace"
 	"github.com/okteto/okteto/cmd/stack"
+	"github.com/okteto/okteto/pkg/analytics"
 	"github.com/okteto/okteto/pkg/config"
 	"github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/log"
@@ -52,8 +54,9 @@ func init() {
 
 func main() {
 	log.Init(logrus.WarnLevel)
-	log.Info("start")
+	log.Debug("start")
 	var logLevel string
+	var noAnalytics bool
 
 	agent, span, ctx := getTracing()
 
@@ -61,13 +64,30 @@ func main() {
 		Use:           fmt.Sprintf("%s COMMAND [ARG...]", config.GetBinaryName()),
 		Short:         "Manage cloud dev environments",
 		SilenceErrors: true,
-		PersistentPreRun: func(ccmd *cobra.Command, args []string) {
-			log.SetLevel(logLevel)
+		PersistentPreRunE: func(ccmd *cobra.Command, args []string) error {
 			ccmd.SilenceUsage = true
+			level := logLevel
+			if !ccmd.Flags().Changed("log-level") && !ccmd.Flags().Changed("loglevel") {
+				if v := os.Getenv("OKTETO_LOG_LEVEL"); v != "" {
+					level = v
+				}
+			}
+			if err := log.SetLevel(level); err != nil {
+				return err
+			}
+			if ccmd.Flags().Changed("no-analytics") {
+				analytics.SetDisabledForCurrentRun(noAnalytics)
+			}
+			return nil
 		},
 	}
 
-	root.PersistentFlags().StringVarP(&logLevel, "loglevel", "l", "warn", "amount of information outputted (debug, info, warn, error)")
+	root.PersistentFlags().StringVarP(&logLevel, "log-level", "l", "info", "amount of information outputted (debug, info, warn, error), overrides the OKTETO_LOG_LEVEL environment variable")
+	root.PersistentFlags().StringVarP(&logLevel, "loglevel", "", "info", "amount of information outputted (debug, info, warn, error)")
+	if err := root.PersistentFlags().MarkDeprecated("loglevel", "use --log-level instead"); err != nil {
+		log.Debugf("failed to deprecate the loglevel flag: %s", err)
+	}
+	root.PersistentFlags().BoolVarP(&noAnalytics, "no-analytics", "", false, "disable analytics for this command (overrides the OKTETO_DISABLE_ANALYTICS environment variable)")
 	root.AddCommand(cmd.Analytics())
 	root.AddCommand(cmd.Version())
 	root.AddCommand(cmd.Login())
@@ -76,13 +96,16 @@ func main() {
 	root.AddCommand(cmd.Delete(ctx))
 	root.AddCommand(namespace.Namespace(ctx))
 	root.AddCommand(stack.Stack(ctx))
+	root.AddCommand(manifest.Manifest())
 	root.AddCommand(cmd.Init())
 	root.AddCommand(cmd.Up())
 	root.AddCommand(cmd.Down())
+	root.AddCommand(cmd.Destroy())
 	root.AddCommand(cmd.Push(ctx))
 	root.AddCommand(cmd.Status())
 	root.AddCommand(cmd.Doctor())
 	root.AddCommand(cmd.Exec())
+	root.AddCommand(cmd.Logs())
 	root.AddCommand(cmd.Restart())
 
 	err := root.Execute()
@@ -96,6 +119,11 @@ func main() {
 	}
 
 	if err != nil {
+		if cErr, ok := err.(errors.CommandError); ok {
+			log.Debugf("command failed: %s", cErr.E)
+			os.Exit(cErr.ExitCode)
+		}
+
 		log.Fail(err.Error())
 		if uErr, ok := err.(errors.UserError); ok {
 			if len(uErr.Hint) > 0 {
@@ -120,7 +148,7 @@ func getTracing() (*agent.Agent, opentracing.Span, context.Context) {
 		span := process.StartSpan()
 		span.SetTag("okteto.version", config.VersionString)
 		ctx = opentracing.ContextWithSpan(ctx, span)
-		log.Info("scope agent configured")
+		log.Debug("scope agent configured")
 		return scope, span, ctx
 	}
 
@@ -19,6 +19,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/denisbrodbeck/machineid"
@@ -39,6 +40,7 @@ const (
 	syncErrorEvent       = "Sync Error"
 	downEvent            = "Down"
 	downVolumesEvent     = "DownVolumes"
+	destroyEvent         = "Destroy"
 	pushEvent            = "Push"
 	statusEvent          = "Status"
 	doctorEvent          = "Doctor"
@@ -51,12 +53,16 @@ const (
 	namespaceCreateEvent = "CreateNamespace"
 	namespaceDeleteEvent = "DeleteNamespace"
 	execEvent            = "Exec"
+	logsEvent            = "Logs"
 	signupEvent          = "Signup"
 	disableEvent         = "Disable Analytics"
 )
 
 var (
 	mixpanelClient mixpanel.Mixpanel
+
+	// disabledForCurrentRun overrides the value of the OKTETO_DISABLE_ANALYTICS environment variable when set
+	disabledForCurrentRun *bool
 )
 
 func init() {
@@ -132,6 +138,11 @@ func TrackExec(success bool) {
 	track(execEvent, success, nil)
 }
 
+// TrackLogs sends a tracking event to mixpanel when the user runs the logs command
+func TrackLogs(success bool) {
+	track(logsEvent, success, nil)
+}
+
 // TrackDown sends a tracking event to mixpanel when the user deactivates a development environment
 func TrackDown(success bool) {
 	track(downEvent, success, nil)
@@ -142,6 +153,11 @@ func TrackDownVolumes(success bool) {
 	track(downVolumesEvent, success, nil)
 }
 
+// TrackDestroy sends a tracking event to mixpanel when the user destroys a development environment
+func TrackDestroy(success bool) {
+	track(destroyEvent, success, nil)
+}
+
 // TrackPush sends a tracking event to mixpanel when the user pushes a development environment
 func TrackPush(success bool, oktetoRegistryURL string) {
 	props := map[string]interface{}{
@@ -202,7 +218,7 @@ func TrackLogin(success bool, name, email, oktetoID, githubID string) {
 			"githubId": githubID,
 		},
 	}); err != nil {
-		log.Infof("failed to update user: %s", err)
+		log.Debugf("failed to update user: %s", err)
 	}
 }
 
@@ -245,7 +261,7 @@ func track(event string, success bool, props map[string]interface{}) {
 		e := &mixpanel.Event{Properties: props}
 		trackID := getTrackID()
 		if err := mixpanelClient.Track(trackID, event, e); err != nil {
-			log.Infof("Failed to send analytics: %s", err)
+			log.Debugf("Failed to send analytics: %s", err)
 		}
 	} else {
 		log.Debugf("not sending event for %s", event)
@@ -282,7 +298,26 @@ func Enable(version string) error {
 	return os.Remove(getFlagPath())
 }
 
+// SetDisabledForCurrentRun enables or disables analytics for the current run.
+// It takes precedence over the OKTETO_DISABLE_ANALYTICS environment variable
+func SetDisabledForCurrentRun(disabled bool) {
+	disabledForCurrentRun = &disabled
+}
+
+func isDisabledForCurrentRun() bool {
+	if disabledForCurrentRun != nil {
+		return *disabledForCurrentRun
+	}
+
+	disabled, err := strconv.ParseBool(os.Getenv("OKTETO_DISABLE_ANALYTICS"))
+	return err == nil && disabled
+}
+
 func isEnabled() bool {
+	if isDisabledForCurrentRun() {
+		return false
+	}
+
 	if _, err := os.Stat(getFlagPath()); !os.IsNotExist(err) {
 		return false
 	}
@@ -307,7 +342,7 @@ func getMachineID() string {
 
 	mid = generateMachineID()
 	if err := okteto.SaveMachineID(mid); err != nil {
-		log.Info("failed to save the machine id")
+		log.Debug("failed to save the machine id")
 		mid = "na"
 	}
 
@@ -89,3 +89,36 @@ func Test_getTrackID(t *testing.T) {
 		})
 	}
 }
+
+func Test_isDisabledForCurrentRun(t *testing.T) {
+	enabled := false
+	disabled := true
+	var tests = []struct {
+		name     string
+		env      string
+		flag     *bool
+		expected bool
+	}{
+		{name: "default", expected: false},
+		{name: "env-disabled", env: "true", expected: true},
+		{name: "env-invalid", env: "yes-please", expected: false},
+		{name: "flag-disabled", flag: &disabled, expected: true},
+		{name: "flag-overrides-env", env: "true", flag: &enabled, expected: false},
+	}
+
+	defer os.Unsetenv("OKTETO_DISABLE_ANALYTICS")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("OKTETO_DISABLE_ANALYTICS", tt.env)
+			disabledForCurrentRun = nil
+			if tt.flag != nil {
+				SetDisabledForCurrentRun(*tt.flag)
+			}
+			defer func() { disabledForCurrentRun = nil }()
+
+			if got := isDisabledForCurrentRun(); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
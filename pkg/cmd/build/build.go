@@ -25,7 +25,7 @@ import (
 func Run(buildKitHost string, isOktetoCluster bool, path, dockerFile, tag, target string, noCache bool, buildArgs []string, progress string) (string, error) {
 	ctx := context.Background()
 
-	log.Infof("building your image on %s", buildKitHost)
+	log.Debugf("building your image on %s", buildKitHost)
 	buildkitClient, err := getBuildkitClient(ctx, isOktetoCluster, buildKitHost)
 	if err != nil {
 		return "", err
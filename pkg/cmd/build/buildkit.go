@@ -16,6 +16,7 @@ package build
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -152,7 +153,7 @@ func getBuildkitClient(ctx context.Context, isOktetoCluster bool, buildKitHost s
 	if isOktetoCluster {
 		c, err := getClientForOktetoCluster(ctx, buildKitHost)
 		if err != nil {
-			log.Infof("failed to create okteto build client: %s", err)
+			log.Debugf("failed to create okteto build client: %s", err)
 			return nil, okErrors.UserError{E: fmt.Errorf("failed to create okteto build client"), Hint: okErrors.ErrNotLogged.Error()}
 		}
 
@@ -214,8 +215,13 @@ func solveBuild(ctx context.Context, c *client.Client, opt *client.SolveOpt, pro
 				c = cn
 			}
 		}
+		// the JSON output reserves stdout for its events
+		var w io.Writer = os.Stdout
+		if log.IsJSONOutput() {
+			w = os.Stderr
+		}
 		// not using shared context to not disrupt display but let it finish reporting errors
-		return progressui.DisplaySolveStatus(context.TODO(), "", c, os.Stdout, ch)
+		return progressui.DisplaySolveStatus(context.TODO(), "", c, w, ch)
 	})
 
 	if err := eg.Wait(); err != nil {
@@ -0,0 +1,147 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/moby/buildkit/frontend/dockerfile/dockerignore"
+)
+
+//GetBuildHash returns a hash of the build context, the Dockerfile and the build parameters.
+//Files excluded by the .dockerignore file of the build context are not part of the hash
+func GetBuildHash(path, dockerFile, tag, target string, buildArgs []string) (string, error) {
+	if dockerFile == "" {
+		dockerFile = filepath.Join(path, "Dockerfile")
+	}
+
+	h := sha256.New()
+	args := append([]string{}, buildArgs...)
+	sort.Strings(args)
+	fmt.Fprintf(h, "tag:%s\ntarget:%s\nargs:%v\n", tag, target, args)
+
+	if err := hashFile(h, "Dockerfile", dockerFile); err != nil {
+		return "", err
+	}
+
+	pm, err := getDockerignoreMatcher(path)
+	if err != nil {
+		return "", err
+	}
+
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+
+		if pm != nil {
+			excluded, err := pm.Matches(rel)
+			if err != nil {
+				return err
+			}
+			if excluded {
+				if info.IsDir() && !hasExceptions(pm, rel) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if !info.Mode().IsRegular() {
+			fmt.Fprintf(h, "%s:%s\n", filepath.ToSlash(rel), info.Mode())
+			return nil
+		}
+
+		return hashFile(h, filepath.ToSlash(rel), p)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to calculate the hash of the build context: %s", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+//hasExceptions returns if an exception pattern of the .dockerignore file can match files under dir,
+//following the same logic used by docker to send the build context
+func hasExceptions(pm *fileutils.PatternMatcher, dir string) bool {
+	if !pm.Exclusions() {
+		return false
+	}
+
+	dirSlash := dir + string(filepath.Separator)
+	for _, p := range pm.Patterns() {
+		if !p.Exclusion() {
+			continue
+		}
+		if strings.HasPrefix(p.String()+string(filepath.Separator), dirSlash) {
+			return true
+		}
+	}
+	return false
+}
+
+func hashFile(w io.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "%s:%s:%d\n", name, info.Mode(), info.Size())
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func getDockerignoreMatcher(path string) (*fileutils.PatternMatcher, error) {
+	f, err := os.Open(filepath.Join(path, ".dockerignore"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	patterns, err := dockerignore.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read .dockerignore: %s", err)
+	}
+
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	return fileutils.NewPatternMatcher(patterns)
+}
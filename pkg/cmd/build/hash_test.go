@@ -0,0 +1,119 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package build
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetBuildHash(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name, content string) {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("Dockerfile", "FROM golang")
+	write("main.go", "package main")
+	write(".dockerignore", "node_modules")
+	write("node_modules/lib/index.js", "1")
+
+	hash := func(args ...string) string {
+		h, err := GetBuildHash(dir, "", "okteto.dev/api:okteto", "", args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+
+	initial := hash()
+	if initial != hash() {
+		t.Error("the hash of the same build context changed")
+	}
+
+	write("node_modules/lib/index.js", "2")
+	if initial != hash() {
+		t.Error("files excluded by .dockerignore changed the hash")
+	}
+
+	if initial == hash("KEY=value") {
+		t.Error("build args didn't change the hash")
+	}
+
+	write("main.go", "package main\n")
+	if initial == hash() {
+		t.Error("a modified file didn't change the hash")
+	}
+
+	modified := hash()
+	write("Dockerfile", "FROM golang:1.14")
+	if modified == hash() {
+		t.Error("a modified Dockerfile didn't change the hash")
+	}
+}
+
+func TestGetBuildHashWithExceptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name, content string) {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("Dockerfile", "FROM golang")
+	write(".dockerignore", "vendor\n!vendor/keep")
+	write("vendor/keep/lib.go", "1")
+	write("vendor/other/lib.go", "1")
+
+	hash := func() string {
+		h, err := GetBuildHash(dir, "", "okteto.dev/api:okteto", "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+
+	initial := hash()
+	write("vendor/other/lib.go", "2")
+	if initial != hash() {
+		t.Error("files excluded by .dockerignore changed the hash")
+	}
+
+	write("vendor/keep/lib.go", "2")
+	if initial == hash() {
+		t.Error("files matching an exception of .dockerignore didn't change the hash")
+	}
+}
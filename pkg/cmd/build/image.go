@@ -32,7 +32,7 @@ func GetRepoNameWithoutTag(name string) string {
 	var domain, remainder string
 	i := strings.IndexRune(name, '@')
 	if i != -1 {
-		return name[:i]
+		name = name[:i]
 	}
 	i = strings.IndexRune(name, '/')
 	if i == -1 || (!strings.ContainsAny(name[:i], ".:") && name[:i] != "localhost") {
@@ -71,6 +71,11 @@ func Test_GetRepoNameWithoutTag(t *testing.T) {
 			image:    "pchico83/test@sha256:e78ad0d316485b7dbffa944a92b29ea4fa26d53c63054605c4fb7a8b787a673c",
 			expected: "pchico83/test",
 		},
+		{
+			name:     "tag-and-sha256",
+			image:    "localhost:5000/test/ubuntu:2@sha256:e78ad0d316485b7dbffa944a92b29ea4fa26d53c63054605c4fb7a8b787a673c",
+			expected: "localhost:5000/test/ubuntu",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -123,6 +128,30 @@ func Test_GetDevImageTag(t *testing.T) {
 			oktetoRegistryURL:   "",
 			expected:            "okteto/test:okteto",
 		},
+		{
+			name:                "sha256-not-in-okteto",
+			dev:                 &model.Dev{Name: "dev", Namespace: "ns"},
+			imageTag:            "",
+			imageFromDeployment: "okteto/test@sha256:e78ad0d316485b7dbffa944a92b29ea4fa26d53c63054605c4fb7a8b787a673c",
+			oktetoRegistryURL:   "",
+			expected:            "okteto/test:okteto",
+		},
+		{
+			name:                "tag-and-sha256-not-in-okteto",
+			dev:                 &model.Dev{Name: "dev", Namespace: "ns"},
+			imageTag:            "",
+			imageFromDeployment: "okteto/test:2@sha256:e78ad0d316485b7dbffa944a92b29ea4fa26d53c63054605c4fb7a8b787a673c",
+			oktetoRegistryURL:   "",
+			expected:            "okteto/test:okteto",
+		},
+		{
+			name:                "sha256-in-okteto",
+			dev:                 &model.Dev{Name: "dev", Namespace: "ns"},
+			imageTag:            "",
+			imageFromDeployment: "okteto/test@sha256:e78ad0d316485b7dbffa944a92b29ea4fa26d53c63054605c4fb7a8b787a673c",
+			oktetoRegistryURL:   okteto.CloudRegistryURL,
+			expected:            "registry.cloud.okteto.net/ns/dev:okteto",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -14,6 +14,7 @@
 package doctor
 
 import (
+	"bufio"
 	"compress/flate"
 	"context"
 	"fmt"
@@ -21,20 +22,41 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/mholt/archiver"
 	"github.com/okteto/okteto/pkg/config"
 	"github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/deployments"
 	"github.com/okteto/okteto/pkg/k8s/pods"
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
 )
 
-//Run runs the "okteto status" sequence
-func Run(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset) (string, error) {
+const (
+	oktetoAnnotationsPrefix = "dev.okteto.com/"
+	redacted                = "<redacted>"
+)
+
+var redactRules = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`<apikey>[^<]*</apikey>`), "<apikey>" + redacted + "</apikey>"},
+	{regexp.MustCompile(`<password>[^<]*</password>`), "<password>" + redacted + "</password>"},
+	{regexp.MustCompile(`(?i)(bearer\s+)[^\s"']+`), "${1}" + redacted},
+	{regexp.MustCompile(`(?i)((?:token|apikey|api-key|password)["']?\s*[:=]\s*["']?)[^\s"'&,]+`), "${1}" + redacted},
+}
+
+//Run runs the "okteto doctor" sequence
+func Run(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset, logLines int) (string, error) {
 	z := archiver.Zip{
 		CompressionLevel:       flate.DefaultCompression,
 		MkdirAll:               true,
@@ -57,20 +79,51 @@ func Run(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset) (string,
 	}
 	defer os.RemoveAll(remoteLogsPath)
 
+	podPath, err := generatePodFile(ctx, dev, c)
+	if err != nil {
+		log.Debugf("error getting the development pod: %s", err)
+	}
+	defer os.RemoveAll(podPath)
+
+	deploymentPath, err := generateDeploymentFile(dev, c)
+	if err != nil {
+		log.Debugf("error getting the deployment annotations: %s", err)
+	}
+	defer os.RemoveAll(deploymentPath)
+
 	now := time.Now()
 	archiveName := fmt.Sprintf("okteto-doctor-%s.zip", now.Format("20060102150405"))
 	files := []string{summaryFilename}
-	if model.FileExists(filepath.Join(config.GetOktetoHome(), "okteto.log")) {
-		files = append(files, filepath.Join(config.GetOktetoHome(), "okteto.log"))
+
+	localFiles := []struct {
+		source string
+		name   string
+		lines  int
+	}{
+		{filepath.Join(config.GetOktetoHome(), "okteto.log"), "okteto.log", logLines},
+		{config.GetSyncthingLogFile(dev.Namespace, dev.Name), "syncthing.log", 0},
+		{filepath.Join(config.GetSyncthingDeploymentHome(dev.Namespace, dev.Name), "config.xml"), "syncthing-config.xml", 0},
 	}
-	if model.FileExists(config.GetSyncthingLogFile(dev.Namespace, dev.Name)) {
-		files = append(files, config.GetSyncthingLogFile(dev.Namespace, dev.Name))
+	for _, f := range localFiles {
+		if !model.FileExists(f.source) {
+			continue
+		}
+		p, err := generateRedactedFile(f.source, f.name, f.lines)
+		if err != nil {
+			log.Debugf("error copying %s: %s", f.source, err)
+			continue
+		}
+		defer os.RemoveAll(filepath.Dir(p))
+		files = append(files, p)
 	}
-	if remoteLogsPath != "" {
-		files = append(files, remoteLogsPath)
+
+	for _, p := range []string{remoteLogsPath, podPath, deploymentPath} {
+		if p != "" {
+			files = append(files, p)
+		}
 	}
 	if err := z.Archive(files, archiveName); err != nil {
-		log.Infof("error while archiving: %s", err)
+		log.Debugf("error while archiving: %s", err)
 		return "", fmt.Errorf("couldn't create archive '%s', please try again: %s", archiveName, err)
 	}
 
@@ -111,3 +164,113 @@ func generateRemoteSyncthingLogsFile(ctx context.Context, dev *model.Dev, c *kub
 	}
 	return remoteLogsPath, nil
 }
+
+func generatePodFile(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset) (string, error) {
+	p, err := pods.GetDevPod(ctx, dev, c, false)
+	if err != nil {
+		return "", err
+	}
+
+	status, err := yaml.Marshal(p.Status)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name: %s\nNamespace: %s\nNode: %s\n\nStatus:\n%s\n", p.Name, p.Namespace, p.Spec.NodeName, status)
+
+	b.WriteString("Events:\n")
+	events, err := c.CoreV1().Events(p.Namespace).List(metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=Pod,involvedObject.name=%s", p.Name),
+	})
+	if err != nil {
+		log.Debugf("error listing the events of pod %s: %s", p.Name, err)
+	} else {
+		items := events.Items
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].LastTimestamp.Before(&items[j].LastTimestamp)
+		})
+		for _, e := range items {
+			fmt.Fprintf(&b, "%s\t%s\t%s\t%s\n", e.LastTimestamp.Format(time.RFC3339), e.Type, e.Reason, e.Message)
+		}
+	}
+
+	return writeTempFile("dev-pod.txt", redact(b.String()))
+}
+
+func generateDeploymentFile(dev *model.Dev, c *kubernetes.Clientset) (string, error) {
+	d, err := deployments.Get(dev, dev.Namespace, c)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Name: %s\nNamespace: %s\n\n", d.Name, d.Namespace)
+	b.WriteString("Labels:\n")
+	writeOktetoEntries(&b, d.Labels)
+	b.WriteString("Annotations:\n")
+	writeOktetoEntries(&b, d.Annotations)
+
+	return writeTempFile("deployment.txt", redact(b.String()))
+}
+
+func writeOktetoEntries(b *strings.Builder, entries map[string]string) {
+	keys := []string{}
+	for k := range entries {
+		if strings.HasPrefix(k, oktetoAnnotationsPrefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(b, "  %s: %s\n", k, entries[k])
+	}
+}
+
+func generateRedactedFile(source, name string, lines int) (string, error) {
+	f, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	content := []string{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		content = append(content, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return writeTempFile(name, redact(strings.Join(tail(content, lines), "\n")))
+}
+
+func writeTempFile(name, content string) (string, error) {
+	tempdir, err := ioutil.TempDir("", "")
+	if err != nil {
+		return "", err
+	}
+	p := path.Join(tempdir, name)
+	if err := ioutil.WriteFile(p, []byte(content), 0600); err != nil {
+		return "", err
+	}
+	return p, nil
+}
+
+//tail returns the last n lines, or all of them if n is not positive
+func tail(lines []string, n int) []string {
+	if n <= 0 || len(lines) <= n {
+		return lines
+	}
+	return lines[len(lines)-n:]
+}
+
+//redact removes the syncthing API keys, passwords and tokens of the content
+func redact(content string) string {
+	for _, r := range redactRules {
+		content = r.re.ReplaceAllString(content, r.replacement)
+	}
+	return content
+}
@@ -0,0 +1,5 @@
+package doctor
+
+import (
+	_ "go.undefinedlabs.com/scopeagent/autoinstrument"
+)
@@ -28,7 +28,7 @@ import (
 //Run runs the "okteto down" sequence
 func Run(dev *model.Dev, d *appsv1.Deployment, trList map[string]*model.Translation, wait bool, c *kubernetes.Clientset) error {
 	if len(trList) == 0 {
-		log.Info("no translations available in the deployment")
+		log.Debug("no translations available in the deployment")
 	}
 
 	for _, tr := range trList {
@@ -52,7 +52,7 @@ func Run(dev *model.Dev, d *appsv1.Deployment, trList map[string]*model.Translat
 	stopSyncthing(dev)
 
 	if err := ssh.RemoveEntry(dev.Name); err != nil {
-		log.Infof("failed to remove ssh entry: %s", err)
+		log.Debugf("failed to remove ssh entry: %s", err)
 	}
 
 	if d == nil {
@@ -79,11 +79,11 @@ func Run(dev *model.Dev, d *appsv1.Deployment, trList map[string]*model.Translat
 func stopSyncthing(dev *model.Dev) {
 	sy, err := syncthing.New(dev)
 	if err != nil {
-		log.Infof("failed to create syncthing instance")
+		log.Debugf("failed to create syncthing instance")
 		return
 	}
 
 	if err := sy.Stop(true); err != nil {
-		log.Infof("failed to stop existing syncthing")
+		log.Debugf("failed to stop existing syncthing")
 	}
 }
@@ -47,13 +47,13 @@ func _waitForDevPodsTermination(c kubernetes.Interface, namespace string, select
 	for i := 0; i < t; i++ {
 		ps, err := pods.ListBySelector(namespace, selector, c)
 		if err != nil {
-			log.Infof("failed to get dev pods with selector %s, exiting: %s", selector, err)
+			log.Debugf("failed to get dev pods with selector %s, exiting: %s", selector, err)
 			return
 		}
 
 		exit := true
 		for i := range ps {
-			log.Infof("waiting for %s/%s to terminate", ps[i].GetNamespace(), ps[i].GetName())
+			log.Debugf("waiting for %s/%s to terminate", ps[i].GetNamespace(), ps[i].GetName())
 			if pods.Exists(ps[i].GetName(), ps[i].GetNamespace(), c) {
 				exit = false
 			}
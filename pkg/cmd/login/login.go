@@ -55,14 +55,14 @@ func WithToken(ctx context.Context, url, token string) (*okteto.User, error) {
 func StartWithBrowser(ctx context.Context, url string) (*Handler, error) {
 	state, err := randToken()
 	if err != nil {
-		log.Infof("couldn't generate random token: %s", err)
+		log.Debugf("couldn't generate random token: %s", err)
 		return nil, fmt.Errorf("couldn't generate a random token, please try again")
 	}
 
 	port, err := model.GetAvailablePort()
 
 	if err != nil {
-		log.Infof("couldn't access the network: %s", err)
+		log.Debugf("couldn't access the network: %s", err)
 		return nil, fmt.Errorf("couldn't access the network")
 	}
 
@@ -16,6 +16,8 @@ package status
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
@@ -26,14 +28,25 @@ import (
 func Run(ctx context.Context, dev *model.Dev, sy *syncthing.Syncthing) (float64, error) {
 	progressLocal, err := sy.GetCompletionProgress(ctx, dev, true)
 	if err != nil {
-		log.Infof("error accessing local syncthing status: %s", err)
+		log.Debugf("error accessing local syncthing status: %s", err)
 		return 0, fmt.Errorf("error accessing local syncthing status")
 	}
 	progressRemote, err := sy.GetCompletionProgress(ctx, dev, false)
 	if err != nil {
-		log.Infof("error accessing remote syncthing status: %s", err)
+		log.Debugf("error accessing remote syncthing status: %s", err)
 		return 0, fmt.Errorf("error accessing remote syncthing status")
 	}
 	progress := (progressLocal + progressRemote) / 2
 	return progress, nil
 }
+
+//IsForwardActive returns true if the local port of a port forward accepts connections
+func IsForwardActive(f model.Forward) bool {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("localhost:%d", f.Local), time.Second)
+	if err != nil {
+		log.Debugf("port forward %d -> %d is not active: %s", f.Local, f.Remote, err)
+		return false
+	}
+	conn.Close()
+	return true
+}
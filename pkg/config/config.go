@@ -71,6 +71,22 @@ func GetDeploymentHome(namespace, name string) string {
 	return d
 }
 
+// GetSyncthingHome returns the path of the folder where the syncthing binary and its data are stored.
+// OKTETO_HOME takes precedence, followed by $XDG_DATA_HOME/okteto and the okteto folder
+func GetSyncthingHome() string {
+	if _, ok := os.LookupEnv("OKTETO_HOME"); !ok {
+		if xdg := os.Getenv("XDG_DATA_HOME"); xdg != "" {
+			return filepath.Join(xdg, "okteto")
+		}
+	}
+	return GetOktetoHome()
+}
+
+// GetSyncthingDeploymentHome returns the path of the syncthing config and database of a development environment
+func GetSyncthingDeploymentHome(namespace, name string) string {
+	return filepath.Join(GetSyncthingHome(), namespace, name)
+}
+
 // GetStateFile returns the path to the state file
 func GetStateFile(namespace, name string) string {
 	return filepath.Join(GetDeploymentHome(namespace, name), "okteto.state")
@@ -81,6 +97,11 @@ func GetSyncthingInfoFile(namespace, name string) string {
 	return filepath.Join(GetDeploymentHome(namespace, name), "syncthing.info")
 }
 
+// GetActiveFile returns the path to the file describing the active development environment
+func GetActiveFile(namespace, name string) string {
+	return filepath.Join(GetDeploymentHome(namespace, name), "active.json")
+}
+
 // GetSyncthingLogFile returns the path to the syncthing log file
 func GetSyncthingLogFile(namespace, name string) string {
 	return filepath.Join(GetDeploymentHome(namespace, name), "syncthing.log")
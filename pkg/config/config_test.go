@@ -71,3 +71,31 @@ func TestGetDeploymentHome(t *testing.T) {
 		t.Errorf("expected %s, got %s", expected, got)
 	}
 }
+
+func TestGetSyncthingHome(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	os.Setenv("XDG_DATA_HOME", filepath.Join(dir, "xdg"))
+	defer os.Unsetenv("XDG_DATA_HOME")
+
+	os.Setenv("OKTETO_HOME", dir)
+	expected := filepath.Join(dir, ".okteto")
+	if got := GetSyncthingHome(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	os.Unsetenv("OKTETO_HOME")
+	expected = filepath.Join(dir, "xdg", "okteto")
+	if got := GetSyncthingHome(); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	expected = filepath.Join(dir, "xdg", "okteto", "ns", "dp")
+	if got := GetSyncthingDeploymentHome("ns", "dp"); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -30,6 +30,17 @@ func (u UserError) Error() string {
 	return u.E.Error()
 }
 
+// CommandError is raised when a command executed in the dev environment exits with a non-zero exit code
+type CommandError struct {
+	E        error
+	ExitCode int
+}
+
+// Error returns the error message
+func (c CommandError) Error() string {
+	return c.E.Error()
+}
+
 var (
 	// ErrNotDevDeployment is raised when we detect that the deployment was returned to production mode
 	ErrNotDevDeployment = errors.New("Deployment is no longer in developer mode")
@@ -58,8 +69,30 @@ var (
 	// ErrLostSyncthing is raised when we lose connectivity with syncthing
 	ErrLostSyncthing = fmt.Errorf("synchronization service unresponsive")
 
+	// ErrLostKubernetesAPI is raised when we lose connectivity with the kubernetes API
+	ErrLostKubernetesAPI = fmt.Errorf("kubernetes API unresponsive")
+
+	// ErrOOMKilled is raised when the development container runs out of memory
+	ErrOOMKilled = UserError{
+		E:    fmt.Errorf("Your development container was terminated because it ran out of memory (OOMKilled)"),
+		Hint: "Increase the memory limit of your development container with the 'resources.limits.memory' field of your okteto manifest and run 'okteto up' again",
+	}
+
+	// ErrLostConnection is raised when okteto up can't reconnect to the development environment
+	ErrLostConnection = fmt.Errorf("Lost connection to your development environment, please check your network connection and try again")
+
 	// ErrNotInDevMode is raised when the eployment is not in dev mode
 	ErrNotInDevMode = fmt.Errorf("Deployment is not in development mode anymore")
+
+	transientErrors = []string{
+		"timeout",
+		"connection refused",
+		"connection reset by peer",
+		"broken pipe",
+		"unexpected eof",
+		"too many requests",
+		"the server is currently unable to handle the request",
+	}
 )
 
 // IsNotFound returns true if err is of the type not found
@@ -71,3 +104,19 @@ func IsNotFound(err error) bool {
 func IsNotExist(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "does not exist")
 }
+
+// IsTransient returns true if err is a transient error that might succeed if retried
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, t := range transientErrors {
+		if strings.Contains(msg, t) {
+			return true
+		}
+	}
+
+	return false
+}
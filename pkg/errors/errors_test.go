@@ -0,0 +1,40 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIsTransient(t *testing.T) {
+	var tests = []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "timeout", err: fmt.Errorf("Get https://10.0.0.1/api: net/http: TLS handshake timeout"), expected: true},
+		{name: "connection-refused", err: fmt.Errorf("dial tcp 10.0.0.1:443: connect: Connection Refused"), expected: true},
+		{name: "not-found", err: fmt.Errorf("deployments.apps \"api\" not found"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -14,6 +14,11 @@
 package client
 
 import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -24,15 +29,28 @@ import (
 var client *kubernetes.Clientset
 var config *rest.Config
 var namespace string
+var kubeContext = os.Getenv("OKTETO_K8S_CONTEXT")
 
 //GetLocal returns a kubernetes client with the local configuration. It will detect if KUBECONFIG is defined.
+//The current context is used unless a different one is set with SetContext or OKTETO_K8S_CONTEXT.
 func GetLocal() (*kubernetes.Clientset, *rest.Config, string, error) {
 	if client == nil {
 		var err error
 
+		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		if kubeContext != "" {
+			kubeconfig, err := loadingRules.Load()
+			if err != nil {
+				return nil, nil, "", err
+			}
+			if err := validateContext(kubeconfig, kubeContext); err != nil {
+				return nil, nil, "", err
+			}
+		}
+
 		clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-			clientcmd.NewDefaultClientConfigLoadingRules(),
-			&clientcmd.ConfigOverrides{ClusterInfo: clientcmdapi.Cluster{Server: ""}})
+			loadingRules,
+			&clientcmd.ConfigOverrides{ClusterInfo: clientcmdapi.Cluster{Server: ""}, CurrentContext: kubeContext})
 
 		namespace, _, err = clientConfig.Namespace()
 		if err != nil {
@@ -52,6 +70,29 @@ func GetLocal() (*kubernetes.Clientset, *rest.Config, string, error) {
 	return client, config, namespace, nil
 }
 
+//SetContext sets the kubeconfig context used by GetLocal instead of the current one
+func SetContext(name string) {
+	kubeContext = name
+	Reset()
+}
+
+func validateContext(kubeconfig *clientcmdapi.Config, name string) error {
+	if _, ok := kubeconfig.Contexts[name]; ok {
+		return nil
+	}
+
+	available := []string{}
+	for c := range kubeconfig.Contexts {
+		available = append(available, c)
+	}
+	sort.Strings(available)
+
+	if len(available) == 0 {
+		return fmt.Errorf("context '%s' not found: your kubeconfig doesn't have any context", name)
+	}
+	return fmt.Errorf("context '%s' not found in your kubeconfig, available contexts are: %s", name, strings.Join(available, ", "))
+}
+
 //Reset cleans the cached client
 func Reset() {
 	client = nil
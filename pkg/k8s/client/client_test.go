@@ -16,6 +16,8 @@ package client
 import (
 	"os"
 	"testing"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 func TestInCluster(t *testing.T) {
@@ -25,3 +27,30 @@ func TestInCluster(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_validateContext(t *testing.T) {
+	kubeconfig := &clientcmdapi.Config{
+		Contexts: map[string]*clientcmdapi.Context{
+			"staging":    {Cluster: "staging", Namespace: "cindy"},
+			"production": {Cluster: "production"},
+		},
+	}
+
+	if err := validateContext(kubeconfig, "staging"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := validateContext(kubeconfig, "dev")
+	if err == nil {
+		t.Fatal("didn't fail with an unknown context")
+	}
+
+	expected := "context 'dev' not found in your kubeconfig, available contexts are: production, staging"
+	if err.Error() != expected {
+		t.Errorf("expected '%s', got '%s'", expected, err.Error())
+	}
+
+	if err := validateContext(&clientcmdapi.Config{}, "dev"); err == nil {
+		t.Error("didn't fail with an empty kubeconfig")
+	}
+}
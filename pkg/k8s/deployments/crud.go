@@ -30,6 +30,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
+const maxGetRetries = 5
+
 //Get returns a deployment object given its name and namespace
 func Get(dev *model.Dev, namespace string, c *kubernetes.Clientset) (*appsv1.Deployment, error) {
 	if namespace == "" {
@@ -66,6 +68,59 @@ func Get(dev *model.Dev, namespace string, c *kubernetes.Clientset) (*appsv1.Dep
 	return d, nil
 }
 
+//GetWithRetry returns a deployment object given its name and namespace, retrying on transient errors
+func GetWithRetry(ctx context.Context, dev *model.Dev, namespace string, c *kubernetes.Clientset) (*appsv1.Deployment, error) {
+	backoff := 500 * time.Millisecond
+	for i := 1; ; i++ {
+		d, err := Get(dev, namespace, c)
+		if err == nil || !errors.IsTransient(err) || i == maxGetRetries {
+			return d, err
+		}
+
+		log.Debugf("failed to get deployment %s/%s (attempt %d/%d), retrying in %s: %s", namespace, dev.Name, i, maxGetRetries, backoff, err)
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			log.Debugf("cancelling call to get deployment %s/%s", namespace, dev.Name)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+//ListDevModeOn returns the deployments of a namespace that are in dev mode
+func ListDevModeOn(namespace string, c *kubernetes.Clientset) ([]appsv1.Deployment, error) {
+	deploys, err := c.AppsV1().Deployments(namespace).List(
+		metav1.ListOptions{
+			LabelSelector: okLabels.DevLabel,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	result := []appsv1.Deployment{}
+	for i := range deploys.Items {
+		if IsDevModeOn(&deploys.Items[i]) {
+			result = append(result, deploys.Items[i])
+		}
+	}
+	return result, nil
+}
+
+//GetTranslationName returns the name of the development environment that activated a deployment, and if it is the interactive one
+func GetTranslationName(d *appsv1.Deployment) (string, bool) {
+	annotations := d.Spec.Template.GetObjectMeta().GetAnnotations()
+	if annotations[okLabels.TranslationAnnotation] == "" {
+		return d.Name, true
+	}
+	tr, err := getTranslationFromAnnotation(annotations)
+	if err != nil || tr.Name == "" {
+		log.Debugf("failed to read the translation of %s/%s: %s", d.Namespace, d.Name, err)
+		return d.Name, true
+	}
+	return tr.Name, tr.Interactive
+}
+
 //GetRevisionAnnotatedDeploymentOrFailed returns a deployment object if it is healthy and annotated with its revision or an error
 func GetRevisionAnnotatedDeploymentOrFailed(dev *model.Dev, c *kubernetes.Clientset, waitUntilDeployed bool) (*appsv1.Deployment, error) {
 	d, err := Get(dev, dev.Namespace, c)
@@ -81,6 +136,12 @@ func GetRevisionAnnotatedDeploymentOrFailed(dev *model.Dev, c *kubernetes.Client
 			if strings.Contains(c.Message, "exceeded quota") {
 				return nil, errors.ErrQuota
 			}
+			if dev.DockerSocket && strings.Contains(c.Message, "hostPath") {
+				return nil, errors.UserError{
+					E:    fmt.Errorf(c.Message),
+					Hint: "Your cluster doesn't allow hostPath volumes, which are required to mount the docker socket. Remove 'dockerSocket' from your okteto manifest and try again",
+				}
+			}
 			return nil, fmt.Errorf(c.Message)
 		}
 	}
@@ -103,7 +164,7 @@ func GetTranslations(dev *model.Dev, d *appsv1.Deployment, c *kubernetes.Clients
 			Version:     model.TranslationVersion,
 			Deployment:  d,
 			Annotations: dev.Annotations,
-			Replicas:    *d.Spec.Replicas,
+			Replicas:    getOriginalReplicas(d),
 			Rules:       []*model.TranslationRule{rule},
 		}
 	}
@@ -122,7 +183,7 @@ func GetTranslations(dev *model.Dev, d *appsv1.Deployment, c *kubernetes.Clients
 				Version:     model.TranslationVersion,
 				Deployment:  d,
 				Annotations: dev.Annotations,
-				Replicas:    *d.Spec.Replicas,
+				Replicas:    getOriginalReplicas(d),
 				Rules:       []*model.TranslationRule{rule},
 			}
 		}
@@ -193,6 +254,31 @@ func IsDevModeOn(d *appsv1.Deployment) bool {
 	return ok
 }
 
+//GetSession returns the okteto up session holding the development environment, if any
+func GetSession(d *appsv1.Deployment) string {
+	return getAnnotation(d.GetObjectMeta(), okLabels.SessionAnnotation)
+}
+
+//ReleaseSession removes the session annotation of a deployment if it's held by session
+func ReleaseSession(name, namespace, session string, c *kubernetes.Clientset) error {
+	d, err := c.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if GetSession(d) != session {
+		return nil
+	}
+
+	annotations := d.GetObjectMeta().GetAnnotations()
+	delete(annotations, okLabels.SessionAnnotation)
+	d.GetObjectMeta().SetAnnotations(annotations)
+	return update(d, c)
+}
+
 //HasBeenChanged returns if a deployment has been updated since the development environment was activated
 func HasBeenChanged(d *appsv1.Deployment) bool {
 	oktetoRevision := d.Annotations[okLabels.RevisionAnnotation]
@@ -221,7 +307,7 @@ func TranslateDevModeOff(d *appsv1.Deployment) (*appsv1.Deployment, error) {
 	if trRulesJSON == "" {
 		dManifest := getAnnotation(d.GetObjectMeta(), oktetoDeploymentAnnotation)
 		if dManifest == "" {
-			log.Infof("%s/%s is not a development environment", d.Namespace, d.Name)
+			log.Debugf("%s/%s is not a development environment", d.Namespace, d.Name)
 			return d, nil
 		}
 		dOrig := &appsv1.Deployment{}
@@ -237,6 +323,8 @@ func TranslateDevModeOff(d *appsv1.Deployment) (*appsv1.Deployment, error) {
 	d.Spec.Replicas = &trRules.Replicas
 	annotations := d.GetObjectMeta().GetAnnotations()
 	delete(annotations, oktetoVersionAnnotation)
+	delete(annotations, okLabels.GitCommitAnnotation)
+	delete(annotations, okLabels.SessionAnnotation)
 	d.GetObjectMeta().SetAnnotations(annotations)
 	annotations = d.Spec.Template.GetObjectMeta().GetAnnotations()
 	if err := deleteUserAnnotations(annotations); err != nil {
@@ -289,18 +377,71 @@ func deleteUserAnnotations(annotations map[string]string) error {
 	return nil
 }
 
+//GetOriginal returns the manifest of a deployment before dev mode was turned on
+func GetOriginal(d *appsv1.Deployment) (*appsv1.Deployment, error) {
+	if !IsDevModeOn(d) {
+		return d.DeepCopy(), nil
+	}
+
+	manifest := getAnnotation(d.GetObjectMeta(), oktetoDeploymentAnnotation)
+	if manifest == "" {
+		return nil, fmt.Errorf("the original manifest of deployment '%s' is not available", d.Name)
+	}
+
+	dOrig := &appsv1.Deployment{}
+	if err := json.Unmarshal([]byte(manifest), dOrig); err != nil {
+		return nil, fmt.Errorf("malformed manifest: %s", err)
+	}
+	return dOrig, nil
+}
+
+//Recreate deletes a deployment and creates it again from the given manifest
+func Recreate(ctx context.Context, d *appsv1.Deployment, c *kubernetes.Clientset) error {
+	log.Debugf("deleting deployment '%s' to recreate it...", d.Name)
+	dClient := c.AppsV1().Deployments(d.Namespace)
+	if err := dClient.Delete(d.Name, &metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("error deleting kubernetes deployment: %s", err)
+	}
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for i := 0; ; i++ {
+		_, err := dClient.Get(d.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			break
+		}
+
+		if i >= maxRetriesUpdateRevision {
+			return fmt.Errorf("kubernetes is taking too long to delete the deployment '%s'", d.Name)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Debug("cancelling call to recreate deployment")
+			return ctx.Err()
+		}
+	}
+
+	d.ResourceVersion = ""
+	d.UID = ""
+	d.CreationTimestamp = metav1.Time{}
+	d.Status = appsv1.DeploymentStatus{}
+	return create(d, c)
+}
+
 //Destroy destroys a k8s service
 func Destroy(dev *model.Dev, c *kubernetes.Clientset) error {
-	log.Infof("deleting deployment '%s'...", dev.Name)
+	log.Debugf("deleting deployment '%s'...", dev.Name)
 	dClient := c.AppsV1().Deployments(dev.Namespace)
 	err := dClient.Delete(dev.Name, &metav1.DeleteOptions{GracePeriodSeconds: &devTerminationGracePeriodSeconds})
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			log.Infof("deployment '%s' was already deleted.", dev.Name)
+			log.Debugf("deployment '%s' was already deleted.", dev.Name)
 			return nil
 		}
 		return fmt.Errorf("error deleting kubernetes deployment: %s", err)
 	}
-	log.Infof("deployment '%s' deleted", dev.Name)
+	log.Debugf("deployment '%s' deleted", dev.Name)
 	return nil
 }
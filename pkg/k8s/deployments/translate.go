@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
 	"github.com/okteto/okteto/pkg/k8s/namespaces"
@@ -35,6 +36,7 @@ const (
 	oktetoVersionAnnotation    = "dev.okteto.com/version"
 	revisionAnnotation         = "deployment.kubernetes.io/revision"
 	oktetoBinName              = "okteto-bin"
+	oktetoDockerSocketVolume   = "okteto-docker-socket"
 
 	//syncthing
 	oktetoBinImageTag      = "okteto/bin:1.1.18"
@@ -52,10 +54,10 @@ var (
 
 func translate(t *model.Translation, ns *apiv1.Namespace, c *kubernetes.Clientset) error {
 	for _, rule := range t.Rules {
-		devContainer := GetDevContainer(&t.Deployment.Spec.Template.Spec, rule.Container)
-		if devContainer == nil {
-			return fmt.Errorf("Container '%s' not found in deployment '%s'", rule.Container, t.Deployment.Name)
+		if err := ValidateDevContainer(t.Deployment, rule.Container); err != nil {
+			return err
 		}
+		devContainer := GetDevContainer(&t.Deployment.Spec.Template.Spec, rule.Container)
 		rule.Container = devContainer.Name
 	}
 
@@ -78,7 +80,7 @@ func translate(t *model.Translation, ns *apiv1.Namespace, c *kubernetes.Clientse
 			return setTranslationAsAnnotation(t.Deployment.Spec.Template.GetObjectMeta(), t)
 		}
 
-		log.Infof("using clientside translation")
+		log.Debugf("using clientside translation")
 	}
 
 	t.Deployment.Status = appsv1.DeploymentStatus{}
@@ -107,6 +109,10 @@ func translate(t *model.Translation, ns *apiv1.Namespace, c *kubernetes.Clientse
 		TranslateOktetoVolumes(&t.Deployment.Spec.Template.Spec, rule)
 		TranslatePodSecurityContext(&t.Deployment.Spec.Template.Spec, rule.SecurityContext)
 		TranslateOktetoDevSecret(&t.Deployment.Spec.Template.Spec, t.Name, rule.Secrets)
+		if rule.DockerSocket {
+			TranslateDockerSocket(&t.Deployment.Spec.Template.Spec, devContainer)
+		}
+		TranslateImagePullSecrets(&t.Deployment.Spec.Template.Spec, rule.ImagePullSecrets)
 		if rule.Marker != "" {
 			TranslateOktetoBinVolumeMounts(devContainer)
 			TranslateOktetoInitBinContainer(&t.Deployment.Spec.Template.Spec)
@@ -119,6 +125,12 @@ func translate(t *model.Translation, ns *apiv1.Namespace, c *kubernetes.Clientse
 func commonTranslation(t *model.Translation) {
 	TranslatePodUserAnnotations(t.Deployment.Spec.Template.GetObjectMeta(), t.Annotations)
 	setAnnotation(t.Deployment.GetObjectMeta(), oktetoVersionAnnotation, okLabels.Version)
+	if t.GitCommit != "" {
+		setAnnotation(t.Deployment.GetObjectMeta(), okLabels.GitCommitAnnotation, t.GitCommit)
+	}
+	if t.Session != "" {
+		setAnnotation(t.Deployment.GetObjectMeta(), okLabels.SessionAnnotation, t.Session)
+	}
 	setLabel(t.Deployment.GetObjectMeta(), okLabels.DevLabel, "true")
 
 	if t.Interactive {
@@ -145,6 +157,30 @@ func GetDevContainer(spec *apiv1.PodSpec, name string) *apiv1.Container {
 	return nil
 }
 
+//ValidateDevContainer checks that the dev container of a given deployment can be identified without ambiguity
+func ValidateDevContainer(d *appsv1.Deployment, name string) error {
+	containers := d.Spec.Template.Spec.Containers
+	if name == "" {
+		if len(containers) > 1 {
+			return fmt.Errorf("Deployment '%s' has %d containers, set the 'container' field in your okteto manifest to one of: %s", d.Name, len(containers), getContainerNames(containers))
+		}
+		return nil
+	}
+
+	if GetDevContainer(&d.Spec.Template.Spec, name) == nil {
+		return fmt.Errorf("Container '%s' does not exist in deployment '%s', available containers are: %s", name, d.Name, getContainerNames(containers))
+	}
+	return nil
+}
+
+func getContainerNames(containers []apiv1.Container) string {
+	names := []string{}
+	for i := range containers {
+		names = append(names, fmt.Sprintf("'%s'", containers[i].Name))
+	}
+	return strings.Join(names, ", ")
+}
+
 //TranslatePodUserAnnotations translates the user provided annotations of pod
 func TranslatePodUserAnnotations(o metav1.Object, annotations map[string]string) {
 	for key, value := range annotations {
@@ -196,10 +232,12 @@ func TranslateDevContainer(c *apiv1.Container, rule *model.TranslationRule) {
 	if !rule.Healthchecks {
 		c.ReadinessProbe = nil
 		c.LivenessProbe = nil
+		c.StartupProbe = nil
 	}
 
 	TranslateResources(c, rule.Resources)
 	TranslateEnvVars(c, rule)
+	TranslateEnvFrom(c, rule)
 	TranslateVolumeMounts(c, rule)
 	TranslateContainerSecurityContext(c, rule.SecurityContext)
 }
@@ -266,6 +304,34 @@ func TranslateEnvVars(c *apiv1.Container, rule *model.TranslationRule) {
 	}
 }
 
+//TranslateEnvFrom translates the secrets and config maps used to populate the environment of a container
+func TranslateEnvFrom(c *apiv1.Container, rule *model.TranslationRule) {
+	for _, e := range rule.EnvFrom {
+		if hasEnvFrom(c.EnvFrom, e) {
+			continue
+		}
+		source := apiv1.EnvFromSource{}
+		if e.SecretRef != "" {
+			source.SecretRef = &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: e.SecretRef}}
+		} else {
+			source.ConfigMapRef = &apiv1.ConfigMapEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: e.ConfigMapRef}}
+		}
+		c.EnvFrom = append(c.EnvFrom, source)
+	}
+}
+
+func hasEnvFrom(sources []apiv1.EnvFromSource, e model.EnvFrom) bool {
+	for _, s := range sources {
+		if e.SecretRef != "" && s.SecretRef != nil && s.SecretRef.Name == e.SecretRef {
+			return true
+		}
+		if e.ConfigMapRef != "" && s.ConfigMapRef != nil && s.ConfigMapRef.Name == e.ConfigMapRef {
+			return true
+		}
+	}
+	return false
+}
+
 //TranslateVolumeMounts translates the volumes attached to a container
 func TranslateVolumeMounts(c *apiv1.Container, rule *model.TranslationRule) {
 	if c.VolumeMounts == nil {
@@ -357,6 +423,55 @@ func TranslateOktetoVolumes(spec *apiv1.PodSpec, rule *model.TranslationRule) {
 	}
 }
 
+//TranslateDockerSocket mounts the docker socket of the node in the dev container
+func TranslateDockerSocket(spec *apiv1.PodSpec, c *apiv1.Container) {
+	found := false
+	for i := range spec.Volumes {
+		if spec.Volumes[i].Name == oktetoDockerSocketVolume {
+			found = true
+			break
+		}
+	}
+	if !found {
+		socketType := apiv1.HostPathSocket
+		spec.Volumes = append(spec.Volumes, apiv1.Volume{
+			Name: oktetoDockerSocketVolume,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath: &apiv1.HostPathVolumeSource{
+					Path: model.DockerSocketPath,
+					Type: &socketType,
+				},
+			},
+		})
+	}
+
+	for _, vm := range c.VolumeMounts {
+		if vm.Name == oktetoDockerSocketVolume {
+			return
+		}
+	}
+	c.VolumeMounts = append(c.VolumeMounts, apiv1.VolumeMount{
+		Name:      oktetoDockerSocketVolume,
+		MountPath: model.DockerSocketPath,
+	})
+}
+
+//TranslateImagePullSecrets adds the image pull secrets of the dev container to the pod spec
+func TranslateImagePullSecrets(spec *apiv1.PodSpec, secrets []string) {
+	for _, name := range secrets {
+		found := false
+		for _, s := range spec.ImagePullSecrets {
+			if s.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			spec.ImagePullSecrets = append(spec.ImagePullSecrets, apiv1.LocalObjectReference{Name: name})
+		}
+	}
+}
+
 //TranslateOktetoBinVolume translates the binaries volume attached to a container
 func TranslateOktetoBinVolume(spec *apiv1.PodSpec) {
 	if spec.Volumes == nil {
@@ -402,7 +517,21 @@ func TranslatePodSecurityContext(spec *apiv1.PodSpec, s *model.SecurityContext)
 
 //TranslateContainerSecurityContext translates the security context attached to a container
 func TranslateContainerSecurityContext(c *apiv1.Container, s *model.SecurityContext) {
-	if s == nil || s.Capabilities == nil {
+	if s == nil {
+		return
+	}
+
+	// the user and group of the container security context take precedence over the pod security context
+	if c.SecurityContext != nil {
+		if s.RunAsUser != nil {
+			c.SecurityContext.RunAsUser = s.RunAsUser
+		}
+		if s.RunAsGroup != nil {
+			c.SecurityContext.RunAsGroup = s.RunAsGroup
+		}
+	}
+
+	if s.Capabilities == nil {
 		return
 	}
 
@@ -366,6 +366,75 @@ services:
 	}
 }
 
+func Test_translateDevModeOnAndOff(t *testing.T) {
+	manifest := []byte(`name: web
+namespace: n
+image: web:dev`)
+
+	dev, err := model.Read(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev.DevPath = "okteto.yml"
+
+	var replicas int32 = 3
+	d := dev.GevSandbox()
+	d.Spec.Replicas = &replicas
+	d.Spec.Template.Spec.Containers[0].Image = "web:prod"
+	probe := &apiv1.Probe{Handler: apiv1.Handler{HTTPGet: &apiv1.HTTPGetAction{Path: "/healthz"}}}
+	d.Spec.Template.Spec.Containers[0].LivenessProbe = probe
+	d.Spec.Template.Spec.Containers[0].ReadinessProbe = probe
+	d.Spec.Template.Spec.Containers[0].StartupProbe = probe
+	dOrig := d.DeepCopy()
+
+	trList, err := GetTranslations(dev, d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := trList[d.Name]
+	if err := translate(tr, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if *tr.Deployment.Spec.Replicas != devReplicas {
+		t.Fatalf("wrong dev replicas: %d", *tr.Deployment.Spec.Replicas)
+	}
+	devContainer := tr.Deployment.Spec.Template.Spec.Containers[0]
+	if devContainer.Image != "web:dev" {
+		t.Fatalf("wrong dev image: %s", devContainer.Image)
+	}
+	if devContainer.LivenessProbe != nil || devContainer.ReadinessProbe != nil || devContainer.StartupProbe != nil {
+		t.Fatalf("probes were not removed from the dev container")
+	}
+
+	trList, err = GetTranslations(dev, tr.Deployment, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trList[d.Name].Replicas != replicas {
+		t.Fatalf("reactivation didn't keep the original replicas: %d", trList[d.Name].Replicas)
+	}
+
+	dReplace, err := GetOriginal(tr.Deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	marshalledReplace, _ := yaml.Marshal(dReplace.Spec)
+	marshalledOrig, _ := yaml.Marshal(dOrig.Spec)
+	if string(marshalledReplace) != string(marshalledOrig) {
+		t.Fatalf("Wrong original.\nActual %s, \nExpected %s", string(marshalledReplace), string(marshalledOrig))
+	}
+
+	dDown, err := TranslateDevModeOff(tr.Deployment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	marshalledDown, _ := yaml.Marshal(dDown.Spec)
+	if string(marshalledDown) != string(marshalledOrig) {
+		t.Fatalf("Wrong down.\nActual %s, \nExpected %s", string(marshalledDown), string(marshalledOrig))
+	}
+}
+
 func Test_translateWithoutVolumes(t *testing.T) {
 	manifest := []byte(`name: web
 namespace: n
@@ -747,6 +816,122 @@ func Test_translateSecurityContext(t *testing.T) {
 	}
 }
 
+func TestTranslateDockerSocket(t *testing.T) {
+	spec := &apiv1.PodSpec{
+		Containers: []apiv1.Container{{Name: "dev"}},
+	}
+	c := &spec.Containers[0]
+
+	// translating twice must not duplicate the volume or the mount
+	TranslateDockerSocket(spec, c)
+	TranslateDockerSocket(spec, c)
+
+	socketType := apiv1.HostPathSocket
+	expectedVolumes := []apiv1.Volume{
+		{
+			Name: oktetoDockerSocketVolume,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath: &apiv1.HostPathVolumeSource{Path: model.DockerSocketPath, Type: &socketType},
+			},
+		},
+	}
+	if !reflect.DeepEqual(spec.Volumes, expectedVolumes) {
+		t.Errorf("wrong volumes: %+v", spec.Volumes)
+	}
+
+	expectedMounts := []apiv1.VolumeMount{{Name: oktetoDockerSocketVolume, MountPath: model.DockerSocketPath}}
+	if !reflect.DeepEqual(c.VolumeMounts, expectedMounts) {
+		t.Errorf("wrong volume mounts: %+v", c.VolumeMounts)
+	}
+}
+
+func Test_translateContainerSecurityContextUser(t *testing.T) {
+	var user int64 = 1000
+	var group int64 = 2000
+	var containerUser int64 = 1
+	s := &model.SecurityContext{RunAsUser: &user, RunAsGroup: &group}
+
+	c := &apiv1.Container{}
+	TranslateContainerSecurityContext(c, s)
+	if c.SecurityContext != nil {
+		t.Errorf("SecurityContext was set: %+v", c.SecurityContext)
+	}
+
+	c = &apiv1.Container{
+		SecurityContext: &apiv1.SecurityContext{RunAsUser: &containerUser},
+	}
+	TranslateContainerSecurityContext(c, s)
+	if *c.SecurityContext.RunAsUser != user {
+		t.Errorf("wrong runAsUser: %d", *c.SecurityContext.RunAsUser)
+	}
+	if *c.SecurityContext.RunAsGroup != group {
+		t.Errorf("wrong runAsGroup: %d", *c.SecurityContext.RunAsGroup)
+	}
+}
+
+func TestTranslateEnvFrom(t *testing.T) {
+	c := &apiv1.Container{
+		EnvFrom: []apiv1.EnvFromSource{
+			{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "db"}}},
+		},
+	}
+	rule := &model.TranslationRule{
+		EnvFrom: []model.EnvFrom{
+			{SecretRef: "db"},
+			{ConfigMapRef: "config"},
+		},
+	}
+
+	TranslateEnvFrom(c, rule)
+	TranslateEnvFrom(c, rule)
+
+	expected := []apiv1.EnvFromSource{
+		{SecretRef: &apiv1.SecretEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "db"}}},
+		{ConfigMapRef: &apiv1.ConfigMapEnvSource{LocalObjectReference: apiv1.LocalObjectReference{Name: "config"}}},
+	}
+	if !reflect.DeepEqual(c.EnvFrom, expected) {
+		t.Errorf("wrong envFrom: %+v", c.EnvFrom)
+	}
+}
+
+func TestTranslateImagePullSecrets(t *testing.T) {
+	spec := &apiv1.PodSpec{
+		ImagePullSecrets: []apiv1.LocalObjectReference{{Name: "registry"}},
+	}
+
+	TranslateImagePullSecrets(spec, []string{"registry", "private"})
+	TranslateImagePullSecrets(spec, []string{"private"})
+
+	expected := []apiv1.LocalObjectReference{{Name: "registry"}, {Name: "private"}}
+	if !reflect.DeepEqual(spec.ImagePullSecrets, expected) {
+		t.Errorf("wrong image pull secrets: %+v", spec.ImagePullSecrets)
+	}
+}
+
+func TestTranslateGitCommit(t *testing.T) {
+	templates := []string{}
+	for _, commit := range []string{"abc", "abc-dirty"} {
+		tr := &model.Translation{
+			Name:       "api",
+			GitCommit:  commit,
+			Deployment: &appsv1.Deployment{},
+		}
+		commonTranslation(tr)
+		if got := tr.Deployment.GetAnnotations()[okLabels.GitCommitAnnotation]; got != commit {
+			t.Errorf("wrong git commit annotation: '%s'", got)
+		}
+
+		if err := setTranslationAsAnnotation(tr.Deployment.Spec.Template.GetObjectMeta(), tr); err != nil {
+			t.Fatal(err)
+		}
+		templates = append(templates, tr.Deployment.Spec.Template.GetAnnotations()[okLabels.TranslationAnnotation])
+	}
+
+	if templates[0] != templates[1] {
+		t.Errorf("the git commit changed the pod template: '%s' and '%s'", templates[0], templates[1])
+	}
+}
+
 func TestTranslateOktetoVolumes(t *testing.T) {
 	var tests = []struct {
 		name     string
@@ -829,3 +1014,58 @@ func TestTranslateOktetoVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDevContainer(t *testing.T) {
+	var tests = []struct {
+		name       string
+		containers []apiv1.Container
+		container  string
+		expectErr  bool
+	}{
+		{
+			name:       "single-container-not-set",
+			containers: []apiv1.Container{{Name: "api"}},
+			container:  "",
+			expectErr:  false,
+		},
+		{
+			name:       "multiple-containers-not-set",
+			containers: []apiv1.Container{{Name: "api"}, {Name: "sidecar"}},
+			container:  "",
+			expectErr:  true,
+		},
+		{
+			name:       "multiple-containers-set",
+			containers: []apiv1.Container{{Name: "api"}, {Name: "sidecar"}},
+			container:  "sidecar",
+			expectErr:  false,
+		},
+		{
+			name:       "container-not-found",
+			containers: []apiv1.Container{{Name: "api"}, {Name: "sidecar"}},
+			container:  "web",
+			expectErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec: appsv1.DeploymentSpec{
+					Template: apiv1.PodTemplateSpec{
+						Spec: apiv1.PodSpec{Containers: tt.containers},
+					},
+				},
+			}
+
+			err := ValidateDevContainer(d, tt.container)
+			if tt.expectErr && err == nil {
+				t.Error("didn't get the expected error")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("got an unexpected error: %s", err)
+			}
+		})
+	}
+}
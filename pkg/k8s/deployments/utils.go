@@ -17,7 +17,9 @@ import (
 	"encoding/json"
 
 	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
+	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -64,3 +66,32 @@ func getTranslationFromAnnotation(annotations map[string]string) (model.Translat
 	}
 	return tr, nil
 }
+
+//getOriginalReplicas returns the replicas of a deployment before dev mode was turned on.
+//A deployment already in dev mode runs with the dev replicas, so they are read from the okteto annotations
+func getOriginalReplicas(d *appsv1.Deployment) int32 {
+	if _, ok := d.Spec.Template.GetObjectMeta().GetAnnotations()[okLabels.TranslationAnnotation]; ok {
+		tr, err := getTranslationFromAnnotation(d.Spec.Template.GetObjectMeta().GetAnnotations())
+		if err == nil {
+			return tr.Replicas
+		}
+		log.Debugf("malformed translation annotation in deployment '%s': %s", d.Name, err)
+	}
+
+	if manifest := getAnnotation(d.GetObjectMeta(), oktetoDeploymentAnnotation); manifest != "" {
+		dOrig := &appsv1.Deployment{}
+		if err := json.Unmarshal([]byte(manifest), dOrig); err == nil {
+			return getReplicas(dOrig)
+		}
+		log.Debugf("malformed manifest annotation in deployment '%s'", d.Name)
+	}
+
+	return getReplicas(d)
+}
+
+func getReplicas(d *appsv1.Deployment) int32 {
+	if d.Spec.Replicas == nil {
+		return 1
+	}
+	return *d.Spec.Replicas
+}
@@ -18,6 +18,7 @@ import (
 
 	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
 	"github.com/okteto/okteto/pkg/model"
+	appsv1 "k8s.io/api/apps/v1"
 )
 
 func Test_set_translation_as_annotation_and_back(t *testing.T) {
@@ -64,3 +65,72 @@ annotations:
 		t.Fatal("Mismatching Replicas count between original and unmarshalled translation")
 	}
 }
+
+func Test_GetTranslationName(t *testing.T) {
+	manifest := []byte(`name: web
+container: dev
+image: web:latest`)
+	dev, err := model.Read(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := dev.GevSandbox()
+	name, interactive := GetTranslationName(d)
+	if name != d.Name || !interactive {
+		t.Fatalf("expected '%s' and interactive for a deployment without translation, got '%s' and %t", d.Name, name, interactive)
+	}
+
+	tr := &model.Translation{Name: "api", Interactive: false}
+	if err := setTranslationAsAnnotation(d.Spec.Template.GetObjectMeta(), tr); err != nil {
+		t.Fatal(err)
+	}
+	name, interactive = GetTranslationName(d)
+	if name != "api" || interactive {
+		t.Fatalf("expected 'api' and not interactive, got '%s' and %t", name, interactive)
+	}
+}
+
+func Test_getOriginalReplicas(t *testing.T) {
+	var devReplicas int32 = 1
+	var prodReplicas int32 = 3
+
+	withTranslation := &appsv1.Deployment{}
+	withTranslation.Spec.Replicas = &devReplicas
+	if err := setTranslationAsAnnotation(withTranslation.Spec.Template.GetObjectMeta(), &model.Translation{Replicas: prodReplicas}); err != nil {
+		t.Fatal(err)
+	}
+
+	withManifest := &appsv1.Deployment{}
+	withManifest.Spec.Replicas = &devReplicas
+	setAnnotation(withManifest.GetObjectMeta(), oktetoDeploymentAnnotation, `{"spec":{"replicas":3}}`)
+
+	notInDevMode := &appsv1.Deployment{}
+	notInDevMode.Spec.Replicas = &prodReplicas
+
+	var tests = []struct {
+		name     string
+		d        *appsv1.Deployment
+		expected int32
+	}{
+		{name: "translation-annotation", d: withTranslation, expected: prodReplicas},
+		{name: "manifest-annotation", d: withManifest, expected: prodReplicas},
+		{name: "not-in-dev-mode", d: notInDevMode, expected: prodReplicas},
+		{name: "nil-replicas", d: &appsv1.Deployment{}, expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getOriginalReplicas(tt.d); got != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+
+	dDown, err := TranslateDevModeOff(withTranslation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *dDown.Spec.Replicas != prodReplicas {
+		t.Errorf("down didn't restore the original replicas: %d", *dDown.Spec.Replicas)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresses
+
+import (
+	"fmt"
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+//GetEndpoints returns the public URLs of the ingresses that route traffic to the services selecting the given pod labels
+func GetEndpoints(namespace string, podLabels map[string]string, c kubernetes.Interface) ([]string, error) {
+	svcList, err := c.CoreV1().Services(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing kubernetes services: %s", err)
+	}
+
+	services := map[string]bool{}
+	for i := range svcList.Items {
+		if selects(&svcList.Items[i], podLabels) {
+			services[svcList.Items[i].Name] = true
+		}
+	}
+
+	if len(services) == 0 {
+		return []string{}, nil
+	}
+
+	iList, err := c.ExtensionsV1beta1().Ingresses(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing kubernetes ingresses: %s", err)
+	}
+
+	found := map[string]bool{}
+	for i := range iList.Items {
+		for _, e := range translateEndpoints(&iList.Items[i], services) {
+			found[e] = true
+		}
+	}
+
+	endpoints := []string{}
+	for e := range found {
+		endpoints = append(endpoints, e)
+	}
+	sort.Strings(endpoints)
+	return endpoints, nil
+}
+
+func selects(s *apiv1.Service, podLabels map[string]string) bool {
+	if len(s.Spec.Selector) == 0 {
+		return false
+	}
+	for k, v := range s.Spec.Selector {
+		if podLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func translateEndpoints(i *extensions.Ingress, services map[string]bool) []string {
+	tlsHosts := map[string]bool{}
+	for _, tls := range i.Spec.TLS {
+		for _, h := range tls.Hosts {
+			tlsHosts[h] = true
+		}
+	}
+
+	result := []string{}
+	for _, rule := range i.Spec.Rules {
+		if rule.Host == "" || rule.HTTP == nil {
+			continue
+		}
+		scheme := "http"
+		if tlsHosts[rule.Host] {
+			scheme = "https"
+		}
+		for _, p := range rule.HTTP.Paths {
+			if !services[p.Backend.ServiceName] {
+				continue
+			}
+			path := p.Path
+			if path == "" {
+				path = "/"
+			}
+			result = append(result, fmt.Sprintf("%s://%s%s", scheme, rule.Host, path))
+		}
+	}
+	return result
+}
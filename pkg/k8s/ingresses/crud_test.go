@@ -0,0 +1,99 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingresses
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newIngress(name, host, path, service string, tls bool) *extensions.Ingress {
+	i := &extensions.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: host,
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{
+								{
+									Path:    path,
+									Backend: extensions.IngressBackend{ServiceName: service},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if tls {
+		i.Spec.TLS = []extensions.IngressTLS{{Hosts: []string{host}}}
+	}
+	return i
+}
+
+func TestGetEndpoints(t *testing.T) {
+	api := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "test"},
+		Spec:       apiv1.ServiceSpec{Selector: map[string]string{"app": "api"}},
+	}
+	db := &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test"},
+		Spec:       apiv1.ServiceSpec{Selector: map[string]string{"app": "db"}},
+	}
+
+	clientset := fake.NewSimpleClientset(
+		api,
+		db,
+		newIngress("api", "api.example.com", "", "api", true),
+		newIngress("api-docs", "docs.example.com", "/docs", "api", false),
+		newIngress("db", "db.example.com", "", "db", false),
+	)
+
+	var tests = []struct {
+		name     string
+		labels   map[string]string
+		expected []string
+	}{
+		{
+			name:     "match",
+			labels:   map[string]string{"app": "api", "version": "1"},
+			expected: []string{"http://docs.example.com/docs", "https://api.example.com/"},
+		},
+		{
+			name:     "no-match",
+			labels:   map[string]string{"app": "web"},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetEndpoints("test", tt.labels, clientset)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package ingresses
+
+import (
+	_ "go.undefinedlabs.com/scopeagent/autoinstrument"
+)
@@ -32,6 +32,12 @@ const (
 	// DeploymentAnnotation indicates the original deployment manifest  when the development environment was activated
 	DeploymentAnnotation = "dev.okteto.com/deployment"
 
+	// GitCommitAnnotation indicates the git commit of the synchronized folder when the development environment was activated
+	GitCommitAnnotation = "dev.okteto.com/git-commit"
+
+	// SessionAnnotation identifies the okteto up session holding the development environment
+	SessionAnnotation = "dev.okteto.com/session"
+
 	// TranslationAnnotation sets the translation rules
 	TranslationAnnotation = "dev.okteto.com/translation"
 
@@ -38,6 +38,7 @@ import (
 const (
 	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
 	maxRetriesPodRunning         = 300 //1min pod is created
+	oomKilledReason              = "OOMKilled"
 )
 
 var (
@@ -115,15 +116,15 @@ func GetDevPod(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset, wai
 		return nil, err
 	}
 
-	log.Infof("deployment %s with revision %v is progressing", d.Name, d.Annotations[deploymentRevisionAnnotation])
+	log.Debugf("deployment %s with revision %v is progressing", d.Name, d.Annotations[deploymentRevisionAnnotation])
 
 	rs, err := replicasets.GetReplicaSetByDeployment(dev, d, c)
 	if rs == nil {
-		log.Infof("failed to get replicaset with revision %v: %s ", d.Annotations[deploymentRevisionAnnotation], err)
+		log.Debugf("failed to get replicaset with revision %v: %s ", d.Annotations[deploymentRevisionAnnotation], err)
 		return nil, err
 	}
 
-	log.Infof("replicaset %s with revison %s is progressing", rs.Name, d.Annotations[deploymentRevisionAnnotation])
+	log.Debugf("replicaset %s with revison %s is progressing", rs.Name, d.Annotations[deploymentRevisionAnnotation])
 
 	return getPodByReplicaSet(dev, rs, c)
 }
@@ -171,7 +172,7 @@ func MonitorDevPod(ctx context.Context, dev *model.Dev, pod *apiv1.Pod, c *kuber
 				log.Errorf("type error getting pod: %s", event)
 				continue
 			}
-			log.Infof("pod %s updated", pod.Name)
+			log.Debugf("pod %s updated", pod.Name)
 			if pod.Status.Phase == apiv1.PodRunning {
 				return pod, nil
 			}
@@ -184,7 +185,7 @@ func MonitorDevPod(ctx context.Context, dev *model.Dev, pod *apiv1.Pod, c *kuber
 				log.Errorf("type error getting event: %s", event)
 				continue
 			}
-			log.Infof("pod %s event: %s", pod.Name, e.Message)
+			log.Debugf("pod %s event: %s", pod.Name, e.Message)
 			switch e.Reason {
 			case "Failed", "FailedScheduling", "FailedCreatePodSandBox", "ErrImageNeverPull", "InspectFailed", "FailedCreatePodContainer":
 				if !strings.HasPrefix(e.Message, "pod has unbound immediate PersistentVolumeClaims") {
@@ -213,6 +214,27 @@ func Exists(podName, namespace string, c kubernetes.Interface) bool {
 	return pod.GetObjectMeta().GetDeletionTimestamp() == nil
 }
 
+//OOMKilled returns true if the dev container of the pod was OOMKilled after a given time
+func OOMKilled(podName, namespace, container string, since time.Time, c kubernetes.Interface) bool {
+	pod, err := c.CoreV1().Pods(namespace).Get(podName, metav1.GetOptions{})
+	if err != nil {
+		log.Debugf("failed to get pod %s/%s: %s", namespace, podName, err)
+		return false
+	}
+
+	for _, s := range pod.Status.ContainerStatuses {
+		if container != "" && s.Name != container {
+			continue
+		}
+		for _, t := range []*apiv1.ContainerStateTerminated{s.State.Terminated, s.LastTerminationState.Terminated} {
+			if t != nil && t.Reason == oomKilledReason && !t.FinishedAt.Time.Before(since) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 //GetDevPodUserID returns the user id running the dev pod
 func GetDevPodUserID(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset) int64 {
 	devPodLogs, err := GetDevPodLogs(ctx, dev, false, c)
@@ -226,24 +248,24 @@ func GetDevPodUserID(ctx context.Context, dev *model.Dev, c *kubernetes.Clientse
 func parseUserID(output string) int64 {
 	lines := strings.Split(output, "\n")
 	if len(lines) == 0 {
-		log.Info("development environment logs not generated. USER cannot be inferred")
+		log.Debug("development environment logs not generated. USER cannot be inferred")
 		return -1
 	}
 
 	if !strings.HasPrefix(lines[0], "USER:") {
-		log.Infof("USER entry not not found in first development environment log line: %s", lines[0])
+		log.Debugf("USER entry not not found in first development environment log line: %s", lines[0])
 		return -1
 	}
 
 	parts := strings.Split(lines[0], ":")
 	if len(parts) != 2 {
-		log.Infof("failed to parse USER entry: %s", lines[0])
+		log.Debugf("failed to parse USER entry: %s", lines[0])
 		return -1
 	}
 
 	result, err := strconv.ParseInt(parts[1], 10, 64)
 	if err != nil {
-		log.Infof("failed to parse USER entry: %s", lines[0])
+		log.Debugf("failed to parse USER entry: %s", lines[0])
 		return -1
 	}
 
@@ -287,6 +309,22 @@ func containerLogs(container string, pod *apiv1.Pod, namespace string, timestamp
 	return buf.String(), nil
 }
 
+//StreamLogs streams the logs of a pod container into the writer until the stream is closed or the context is cancelled
+func StreamLogs(ctx context.Context, podName, namespace string, opts *apiv1.PodLogOptions, w io.Writer, c kubernetes.Interface) error {
+	req := c.CoreV1().Pods(namespace).GetLogs(podName, opts)
+	logsStream, err := req.Context(ctx).Stream()
+	if err != nil {
+		return err
+	}
+	defer logsStream.Close()
+
+	_, err = io.Copy(w, logsStream)
+	if err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
 // Restart restarts the pods of a deployment
 func Restart(dev *model.Dev, c *kubernetes.Clientset, sn string) error {
 	pods, err := c.CoreV1().Pods(dev.Namespace).List(
@@ -295,7 +333,7 @@ func Restart(dev *model.Dev, c *kubernetes.Clientset, sn string) error {
 		},
 	)
 	if err != nil {
-		log.Infof("error listing pods to restart: %s", err)
+		log.Debugf("error listing pods to restart: %s", err)
 		return fmt.Errorf("failed to retrieve dev environment information")
 	}
 
@@ -328,7 +366,7 @@ func waitUntilRunning(namespace, selector string, c *kubernetes.Clientset) error
 
 	for i := 0; i < 60; i++ {
 		if i%5 == 0 {
-			log.Infof("checking if pods are ready")
+			log.Debugf("checking if pods are ready")
 		}
 
 		pods, err := c.CoreV1().Pods(namespace).List(
@@ -338,7 +376,7 @@ func waitUntilRunning(namespace, selector string, c *kubernetes.Clientset) error
 		)
 
 		if err != nil {
-			log.Infof("error listing pods to check status after restart: %s", err)
+			log.Debugf("error listing pods to check status after restart: %s", err)
 			return fmt.Errorf("failed to retrieve dev environment information")
 		}
 
@@ -353,21 +391,21 @@ func waitUntilRunning(namespace, selector string, c *kubernetes.Clientset) error
 			case apiv1.PodRunning:
 				if isRunning(&pods.Items[i]) {
 					if _, ok := notready[pods.Items[i].GetName()]; ok {
-						log.Infof("pod/%s is ready", pods.Items[i].GetName())
+						log.Debugf("pod/%s is ready", pods.Items[i].GetName())
 						delete(notready, pods.Items[i].GetName())
 					}
 				} else {
 					allRunning = false
 					notready[pods.Items[i].GetName()] = true
 					if i%5 == 0 {
-						log.Infof("pod/%s is not ready", pods.Items[i].GetName())
+						log.Debugf("pod/%s is not ready", pods.Items[i].GetName())
 					}
 				}
 			}
 		}
 
 		if allRunning {
-			log.Infof("pods are ready")
+			log.Debugf("pods are ready")
 			return nil
 		}
 
@@ -15,6 +15,7 @@ package pods
 
 import (
 	"testing"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -177,3 +178,52 @@ func Test_parseUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestOOMKilled(t *testing.T) {
+	since := time.Now()
+	var tests = []struct {
+		name       string
+		terminated *apiv1.ContainerStateTerminated
+		expected   bool
+	}{
+		{
+			name:     "running",
+			expected: false,
+		},
+		{
+			name:       "oomkilled",
+			terminated: &apiv1.ContainerStateTerminated{Reason: "OOMKilled", FinishedAt: metav1.NewTime(since.Add(time.Second))},
+			expected:   true,
+		},
+		{
+			name:       "oomkilled-before",
+			terminated: &apiv1.ContainerStateTerminated{Reason: "OOMKilled", FinishedAt: metav1.NewTime(since.Add(-time.Minute))},
+			expected:   false,
+		},
+		{
+			name:       "error",
+			terminated: &apiv1.ContainerStateTerminated{Reason: "Error", FinishedAt: metav1.NewTime(since.Add(time.Second))},
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &apiv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "test"},
+				Status: apiv1.PodStatus{
+					ContainerStatuses: []apiv1.ContainerStatus{
+						{
+							Name:                 "dev",
+							LastTerminationState: apiv1.ContainerState{Terminated: tt.terminated},
+						},
+					},
+				},
+			}
+			c := fake.NewSimpleClientset(pod)
+			if got := OOMKilled("pod", "test", "dev", since, c); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -44,7 +44,7 @@ func GetReplicaSetByDeployment(dev *model.Dev, d *appsv1.Deployment, c *kubernet
 		for _, or := range rsList.Items[i].OwnerReferences {
 			if or.UID == d.UID {
 				if v, ok := rsList.Items[i].Annotations[deploymentRevisionAnnotation]; ok && v == d.Annotations[deploymentRevisionAnnotation] {
-					log.Infof("replicaset %s with revison %s is progressing", rsList.Items[i].Name, d.Annotations[deploymentRevisionAnnotation])
+					log.Debugf("replicaset %s with revison %s is progressing", rsList.Items[i].Name, d.Annotations[deploymentRevisionAnnotation])
 					return &rsList.Items[i], nil
 				}
 			}
@@ -21,7 +21,7 @@ import (
 )
 
 const configXML = `<configuration version="29">
-<folder id="okteto-{{ .Dev.Name }}" label="{{ .Dev.Name }}" path="{{ .Dev.MountPath }}" type="sendreceive" rescanIntervalS="300" fsWatcherEnabled="true" fsWatcherDelayS="1" ignorePerms="false" autoNormalize="true">
+<folder id="okteto-{{ .Dev.Name }}" label="{{ .Dev.Name }}" path="{{ .Dev.MountPath }}" type="sendreceive" rescanIntervalS="{{ .Dev.Sync.RescanInterval }}" fsWatcherEnabled="true" fsWatcherDelayS="1" ignorePerms="{{ $.Dev.Sync.IgnorePermissions }}" autoNormalize="true">
     <filesystemType>basic</filesystemType>
     <device id="ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR" introducedBy=""></device>
     <device id="ATOPHFJ-VPVLDFY-QVZDCF2-OQQ7IOW-OG4DIXF-OA7RWU3-ZYA4S22-SI4XVAU" introducedBy=""></device>
@@ -42,6 +42,29 @@ const configXML = `<configuration version="29">
     <markerName>{{ .Dev.DevPath }}</markerName>
     <useLargeBlocks>false</useLargeBlocks>
 </folder>
+{{ range .Folders }}
+<folder id="{{ .ID }}" label="{{ .ID }}" path="{{ .RemotePath }}" type="{{ if .ReadOnly }}receiveonly{{ else }}sendreceive{{ end }}" rescanIntervalS="{{ $.Dev.Sync.RescanInterval }}" fsWatcherEnabled="true" fsWatcherDelayS="1" ignorePerms="{{ $.Dev.Sync.IgnorePermissions }}" autoNormalize="true">
+    <filesystemType>basic</filesystemType>
+    <device id="ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR" introducedBy=""></device>
+    <device id="ATOPHFJ-VPVLDFY-QVZDCF2-OQQ7IOW-OG4DIXF-OA7RWU3-ZYA4S22-SI4XVAU" introducedBy=""></device>
+    <minDiskFree unit="%">1</minDiskFree>
+    <versioning></versioning>
+    <copiers>0</copiers>
+    <pullerMaxPendingKiB>0</pullerMaxPendingKiB>
+    <hashers>0</hashers>
+    <order>random</order>
+    <ignoreDelete>false</ignoreDelete>
+    <scanProgressIntervalS>2</scanProgressIntervalS>
+    <pullerPauseS>0</pullerPauseS>
+    <maxConflicts>0</maxConflicts>
+    <disableSparseFiles>false</disableSparseFiles>
+    <disableTempIndexes>false</disableTempIndexes>
+    <paused>false</paused>
+    <weakHashThresholdPct>25</weakHashThresholdPct>
+    <markerName>.stfolder</markerName>
+    <useLargeBlocks>false</useLargeBlocks>
+</folder>
+{{ end }}
 <device id="ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR" name="local" compression="metadata" introducer="false" skipIntroductionRemovals="false" introducedBy="">
     <address>dynamic</address>
     <paused>false</paused>
@@ -65,7 +88,7 @@ const configXML = `<configuration version="29">
 </gui>
 <ldap></ldap>
 <options>
-    <listenAddress>default</listenAddress>
+    <listenAddress>tcp://0.0.0.0:22000</listenAddress>
     <globalAnnounceServer>default</globalAnnounceServer>
     <globalAnnounceEnabled>false</globalAnnounceEnabled>
     <localAnnounceEnabled>false</localAnnounceEnabled>
@@ -77,7 +100,7 @@ const configXML = `<configuration version="29">
     <relaysEnabled>false</relaysEnabled>
     <relayReconnectIntervalM>10</relayReconnectIntervalM>
     <startBrowser>false</startBrowser>
-    <natEnabled>true</natEnabled>
+    <natEnabled>false</natEnabled>
     <natLeaseMinutes>60</natLeaseMinutes>
     <natRenewalMinutes>30</natRenewalMinutes>
     <natTimeoutSeconds>10</natTimeoutSeconds>
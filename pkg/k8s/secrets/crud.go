@@ -28,6 +28,9 @@ import (
 
 const (
 	oktetoSecretTemplate = "okteto-%s"
+
+	// maxSecretsSize is the maximum size of the data of a kubernetes secret
+	maxSecretsSize = 1024 * 1024
 )
 
 // Get returns the value of a secret
@@ -63,6 +66,7 @@ func Create(dev *model.Dev, c *kubernetes.Clientset, s *syncthing.Syncthing) err
 		},
 	}
 
+	size := 0
 	for _, s := range dev.Secrets {
 		content, err := ioutil.ReadFile(s.LocalPath)
 		if err != nil {
@@ -70,6 +74,11 @@ func Create(dev *model.Dev, c *kubernetes.Clientset, s *syncthing.Syncthing) err
 		}
 		log.Debugf("added configuration secret %s", s.GetKeyName())
 		data.Data[s.GetKeyName()] = content
+		size += len(content)
+	}
+
+	if size > maxSecretsSize {
+		log.Yellow("The files defined in 'secrets' take %d bytes, more than the %d bytes allowed in a Kubernetes secret", size, maxSecretsSize)
 	}
 
 	if sct.Name == "" {
@@ -78,13 +87,13 @@ func Create(dev *model.Dev, c *kubernetes.Clientset, s *syncthing.Syncthing) err
 			return fmt.Errorf("error creating kubernetes sync secret: %s", err)
 		}
 
-		log.Infof("created okteto secret '%s'.", secretName)
+		log.Debugf("created okteto secret '%s'.", secretName)
 	} else {
 		_, err := c.CoreV1().Secrets(dev.Namespace).Update(data)
 		if err != nil {
 			return fmt.Errorf("error updating kubernetes okteto secret: %s", err)
 		}
-		log.Infof("okteto secret '%s' was updated.", secretName)
+		log.Debugf("okteto secret '%s' was updated.", secretName)
 	}
 	return nil
 }
@@ -31,41 +31,50 @@ func CreateDev(dev *model.Dev, c *kubernetes.Clientset) error {
 		return fmt.Errorf("error getting kubernetes service: %s", err)
 	}
 
-	s := translate(dev)
+	s := Translate(dev)
 	sClient := c.CoreV1().Services(dev.Namespace)
 
 	if old.Name == "" {
-		log.Infof("creating service '%s'...", s.Name)
+		log.Debugf("creating service '%s'...", s.Name)
 		_, err = sClient.Create(s)
 		if err != nil {
 			return fmt.Errorf("error creating kubernetes service: %s", err)
 		}
-		log.Infof("created service '%s'.", s.Name)
+		log.Debugf("created service '%s'.", s.Name)
 	} else {
-		log.Infof("updating service '%s'...", s.Name)
+		log.Debugf("updating service '%s'...", s.Name)
 		old.Spec.Ports = s.Spec.Ports
+		if dev.Service != nil {
+			old.Spec.Type = s.Spec.Type
+			if old.Annotations == nil {
+				old.Annotations = map[string]string{}
+			}
+			for k, v := range dev.Service.Annotations {
+				old.Annotations[k] = v
+			}
+		}
 		_, err = sClient.Update(old)
 		if err != nil {
 			return fmt.Errorf("error updating kubernetes service: %s", err)
 		}
-		log.Infof("updated service '%s'.", s.Name)
+		log.Debugf("updated service '%s'.", s.Name)
 	}
 	return nil
 }
 
 //DestroyDev destroys the default service for a dev environment
 func DestroyDev(dev *model.Dev, c *kubernetes.Clientset) error {
-	log.Infof("deleting service '%s'...", dev.Name)
+	log.Debugf("deleting service '%s'...", dev.Name)
 	sClient := c.CoreV1().Services(dev.Namespace)
 	err := sClient.Delete(dev.Name, &metav1.DeleteOptions{})
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			log.Infof("service '%s' was already deleted.", dev.Name)
+			log.Debugf("service '%s' was already deleted.", dev.Name)
 			return nil
 		}
 		return fmt.Errorf("error deleting kubernetes service: %s", err)
 	}
-	log.Infof("service '%s' deleted", dev.Name)
+	log.Debugf("service '%s' deleted", dev.Name)
 	return nil
 }
 
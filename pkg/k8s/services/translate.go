@@ -24,11 +24,21 @@ const (
 	oktetoAutoIngressAnnotation = "dev.okteto.com/auto-ingress"
 )
 
-func translate(dev *model.Dev) *apiv1.Service {
+//Translate returns the service of a development environment
+func Translate(dev *model.Dev) *apiv1.Service {
 	annotations := map[string]string{}
 	if len(dev.Services) == 0 {
 		annotations[oktetoAutoIngressAnnotation] = "true"
 	}
+	serviceType := apiv1.ServiceTypeClusterIP
+	if dev.Service != nil {
+		for k, v := range dev.Service.Annotations {
+			annotations[k] = v
+		}
+		if dev.Service.Type != "" {
+			serviceType = dev.Service.Type
+		}
+	}
 	return &apiv1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        dev.Name,
@@ -37,7 +47,7 @@ func translate(dev *model.Dev) *apiv1.Service {
 		},
 		Spec: apiv1.ServiceSpec{
 			Selector: map[string]string{"app": dev.Name},
-			Type:     apiv1.ServiceTypeClusterIP,
+			Type:     serviceType,
 			Ports: []apiv1.ServicePort{
 				apiv1.ServicePort{
 					Name:       dev.Name,
@@ -0,0 +1,76 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/model"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestTranslate(t *testing.T) {
+	var tests = []struct {
+		name                string
+		dev                 *model.Dev
+		expectedType        apiv1.ServiceType
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:                "default",
+			dev:                 &model.Dev{Name: "api", Namespace: "cindy"},
+			expectedType:        apiv1.ServiceTypeClusterIP,
+			expectedAnnotations: map[string]string{oktetoAutoIngressAnnotation: "true"},
+		},
+		{
+			name: "custom",
+			dev: &model.Dev{
+				Name:      "api",
+				Namespace: "cindy",
+				Service: &model.ServiceInfo{
+					Type:        apiv1.ServiceTypeLoadBalancer,
+					Annotations: map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+				},
+			},
+			expectedType: apiv1.ServiceTypeLoadBalancer,
+			expectedAnnotations: map[string]string{
+				oktetoAutoIngressAnnotation:                             "true",
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "true",
+			},
+		},
+		{
+			name: "annotations-only",
+			dev: &model.Dev{
+				Name:      "api",
+				Namespace: "cindy",
+				Service:   &model.ServiceInfo{Annotations: map[string]string{"key": "value"}},
+			},
+			expectedType:        apiv1.ServiceTypeClusterIP,
+			expectedAnnotations: map[string]string{oktetoAutoIngressAnnotation: "true", "key": "value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Translate(tt.dev)
+			if s.Spec.Type != tt.expectedType {
+				t.Errorf("expected type %s, got %s", tt.expectedType, s.Spec.Type)
+			}
+			if !reflect.DeepEqual(s.Annotations, tt.expectedAnnotations) {
+				t.Errorf("expected annotations %v, got %v", tt.expectedAnnotations, s.Annotations)
+			}
+		})
+	}
+}
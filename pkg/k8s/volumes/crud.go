@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
 
@@ -36,7 +37,7 @@ const (
 //Create deploys the volume claim for a given dev environment
 func Create(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset) error {
 	vClient := c.CoreV1().PersistentVolumeClaims(dev.Namespace)
-	pvc := translate(dev)
+	pvc := Translate(dev)
 	k8Volume, err := vClient.Get(pvc.Name, metav1.GetOptions{})
 	if err != nil && !strings.Contains(err.Error(), "not found") {
 		return fmt.Errorf("error getting kubernetes volume claim: %s", err)
@@ -44,7 +45,7 @@ func Create(ctx context.Context, dev *model.Dev, c *kubernetes.Clientset) error
 	if k8Volume.Name != "" {
 		return checkPVCValues(k8Volume, dev)
 	}
-	log.Infof("creating volume claim '%s'...", pvc.Name)
+	log.Debugf("creating volume claim '%s'...", pvc.Name)
 	_, err = vClient.Create(pvc)
 	if err != nil {
 		return fmt.Errorf("error creating kubernetes volume claim: %s", err)
@@ -87,14 +88,14 @@ func checkPVCValues(pvc *apiv1.PersistentVolumeClaim, dev *model.Dev) error {
 //Destroy destroys the volume claim for a given dev environment
 func Destroy(dev *model.Dev, c *kubernetes.Clientset) error {
 	vClient := c.CoreV1().PersistentVolumeClaims(dev.Namespace)
-	log.Infof("destroying volume claim '%s'...", dev.GetVolumeName())
+	log.Debugf("destroying volume claim '%s'...", dev.GetVolumeName())
 
 	ticker := time.NewTicker(1 * time.Second)
 	for i := 0; i < maxRetries; i++ {
 		err := vClient.Delete(dev.GetVolumeName(), &metav1.DeleteOptions{})
 		if err != nil {
 			if strings.Contains(err.Error(), "not found") {
-				log.Infof("volume claim '%s' successfully destroyed", dev.GetVolumeName())
+				log.Debugf("volume claim '%s' successfully destroyed", dev.GetVolumeName())
 				return nil
 			}
 
@@ -103,7 +104,7 @@ func Destroy(dev *model.Dev, c *kubernetes.Clientset) error {
 
 		<-ticker.C
 		if i%10 == 5 {
-			log.Infof("waiting for volume claim '%s' to be destroyed...", dev.GetVolumeName())
+			log.Debugf("waiting for volume claim '%s' to be destroyed...", dev.GetVolumeName())
 		}
 	}
 	if err := checkIfAttached(dev, c); err != nil {
@@ -113,22 +114,52 @@ func Destroy(dev *model.Dev, c *kubernetes.Clientset) error {
 }
 
 func checkIfAttached(dev *model.Dev, c *kubernetes.Clientset) error {
+	podName := GetAttachedPod(dev, c)
+	if podName == "" {
+		return nil
+	}
+	return fmt.Errorf("can't delete your volume claim since it's still attached to 'pod/%s'", podName)
+}
+
+//GetAttachedPod returns the name of the pod the volume claim of a given dev environment is attached to
+func GetAttachedPod(dev *model.Dev, c *kubernetes.Clientset) string {
+	return getAttachedPod(dev, c, func(*apiv1.Pod) bool { return true })
+}
+
+//GetForeignAttachedPod returns the name of a pod the volume claim of a given dev environment is attached to,
+//ignoring the pods being deleted and the dev pods of the development environment, which release the volume claim on 'okteto down'
+func GetForeignAttachedPod(dev *model.Dev, c *kubernetes.Clientset) string {
+	return getAttachedPod(dev, c, func(pod *apiv1.Pod) bool { return !isReleasingVolume(pod, dev) })
+}
+
+//getAttachedPod returns the name of the first pod accepted by filter the volume claim of a given dev environment is attached to
+func getAttachedPod(dev *model.Dev, c *kubernetes.Clientset, filter func(*apiv1.Pod) bool) string {
 	pods, err := c.CoreV1().Pods(dev.Namespace).List(metav1.ListOptions{})
 	if err != nil {
-		log.Infof("failed to get available pods: %s", err)
-		return nil
+		log.Debugf("failed to get available pods: %s", err)
+		return ""
 	}
 
 	for i := range pods.Items {
+		if !filter(&pods.Items[i]) {
+			continue
+		}
 		for j := range pods.Items[i].Spec.Volumes {
 			if pods.Items[i].Spec.Volumes[j].PersistentVolumeClaim != nil {
 				if pods.Items[i].Spec.Volumes[j].PersistentVolumeClaim.ClaimName == dev.GetVolumeName() {
-					log.Infof("pvc/%s is still attached to pod/%s", dev.GetVolumeName(), pods.Items[i].Name)
-					return fmt.Errorf("can't delete your volume claim since it's still attached to 'pod/%s'", pods.Items[i].Name)
+					log.Debugf("pvc/%s is still attached to pod/%s", dev.GetVolumeName(), pods.Items[i].Name)
+					return pods.Items[i].Name
 				}
 			}
 		}
 	}
 
-	return nil
+	return ""
+}
+
+func isReleasingVolume(pod *apiv1.Pod, dev *model.Dev) bool {
+	if pod.DeletionTimestamp != nil {
+		return true
+	}
+	return pod.Labels[okLabels.InteractiveDevLabel] == dev.Name || pod.Labels[okLabels.DetachedDevLabel] == dev.Name
 }
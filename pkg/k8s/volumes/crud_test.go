@@ -16,9 +16,11 @@ package volumes
 import (
 	"testing"
 
+	okLabels "github.com/okteto/okteto/pkg/k8s/labels"
 	"github.com/okteto/okteto/pkg/model"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func Test_checkPVCValues(t *testing.T) {
@@ -140,3 +142,47 @@ func Test_checkPVCValues(t *testing.T) {
 		})
 	}
 }
+
+func Test_isReleasingVolume(t *testing.T) {
+	now := metav1.Now()
+	dev := &model.Dev{Name: "web"}
+	var tests = []struct {
+		name     string
+		pod      *apiv1.Pod
+		expected bool
+	}{
+		{
+			name:     "interactive-dev-pod",
+			pod:      &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{okLabels.InteractiveDevLabel: "web"}}},
+			expected: true,
+		},
+		{
+			name:     "detached-dev-pod",
+			pod:      &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{okLabels.DetachedDevLabel: "web"}}},
+			expected: true,
+		},
+		{
+			name:     "terminating-pod",
+			pod:      &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &now}},
+			expected: true,
+		},
+		{
+			name:     "other-dev-pod",
+			pod:      &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{okLabels.InteractiveDevLabel: "api"}}},
+			expected: false,
+		},
+		{
+			name:     "other-pod",
+			pod:      &apiv1.Pod{},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isReleasingVolume(tt.pod, dev); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
@@ -20,7 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func translate(dev *model.Dev) *apiv1.PersistentVolumeClaim {
+//Translate returns the persistent volume claim of a development environment
+func Translate(dev *model.Dev) *apiv1.PersistentVolumeClaim {
 	pvc := &apiv1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: dev.GetVolumeName(),
@@ -65,7 +65,7 @@ func ProcessDirectory(root string) (string, error) {
 
 		relativePath, err := filepath.Rel(root, path)
 		if err != nil {
-			log.Info(err)
+			log.Debug(err)
 			return nil
 		}
 
@@ -95,7 +95,7 @@ func ProcessDirectory(root string) (string, error) {
 			if language, ok = enry.GetLanguageByFilename(path); !ok {
 				content, err := readFile(path, readFileLimit)
 				if err != nil {
-					log.Info(err)
+					log.Debug(err)
 					return nil
 				}
 
@@ -139,7 +139,7 @@ func refineJavaChoice(root string) string {
 		return gradle
 	}
 
-	log.Infof("didn't found %s : %s", p, err)
+	log.Debugf("didn't found %s : %s", p, err)
 	return maven
 }
 
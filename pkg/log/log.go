@@ -14,11 +14,13 @@
 package log
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/google/uuid"
@@ -46,6 +48,17 @@ var (
 type logger struct {
 	out  *logrus.Logger
 	file *logrus.Entry
+	json bool
+}
+
+// Event represents a machine-readable status event
+type Event struct {
+	Event     string   `json:"event"`
+	Namespace string   `json:"namespace,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Forwards  []string `json:"forwards,omitempty"`
+	Endpoints []string `json:"endpoints,omitempty"`
+	Message   string   `json:"message,omitempty"`
 }
 
 var log = &logger{
@@ -88,12 +101,25 @@ func getRollingLog(path string) io.Writer {
 	}
 }
 
-// SetLevel sets the level of the main logger
-func SetLevel(level string) {
+// SetLevel sets the level of the main logger. Supported levels are debug, info, warn and error
+func SetLevel(level string) error {
+	switch strings.ToLower(level) {
+	case "debug", "info", "warn", "warning", "error":
+	default:
+		return fmt.Errorf("invalid log level '%s', must be one of debug, info, warn or error", level)
+	}
+
 	l, err := logrus.ParseLevel(level)
-	if err == nil {
-		log.out.SetLevel(l)
+	if err != nil {
+		return err
 	}
+	log.out.SetLevel(l)
+	return nil
+}
+
+// IsVerbose returns true if debug logs are displayed, which would corrupt animated output like spinners
+func IsVerbose() bool {
+	return log.out.IsLevelEnabled(logrus.DebugLevel)
 }
 
 // Debug writes a debug-level log
@@ -144,15 +170,28 @@ func Errorf(format string, args ...interface{}) {
 	}
 }
 
+// writeFile writes the decorated messages to the log file only, as they are already displayed
+func writeFile(format string, args ...interface{}) {
+	if log.file != nil {
+		log.file.Infof(format, args...)
+	}
+}
+
 // Yellow writes a line in yellow
 func Yellow(format string, args ...interface{}) {
-	log.out.Infof(format, args...)
+	writeFile(format, args...)
+	if log.json {
+		return
+	}
 	fmt.Fprintln(color.Output, yellowString(format, args...))
 }
 
 // Green writes a line in green
 func Green(format string, args ...interface{}) {
-	log.out.Infof(format, args...)
+	writeFile(format, args...)
+	if log.json {
+		return
+	}
 	fmt.Fprintln(color.Output, greenString(format, args...))
 }
 
@@ -163,30 +202,72 @@ func BlueString(format string, args ...interface{}) string {
 
 // Success prints a message with the success symbol first, and the text in green
 func Success(format string, args ...interface{}) {
-	log.out.Infof(format, args...)
+	writeFile(format, args...)
+	if log.json {
+		return
+	}
 	fmt.Fprintf(color.Output, "%s %s\n", successSymbol, greenString(format, args...))
 }
 
 // Information prints a message with the information symbol first, and the text in blue
 func Information(format string, args ...interface{}) {
-	log.out.Infof(format, args...)
+	writeFile(format, args...)
+	if log.json {
+		return
+	}
 	fmt.Fprintf(color.Output, "%s %s\n", informationSymbol, blueString(format, args...))
 }
 
 // Hint prints a message with the text in blue
 func Hint(format string, args ...interface{}) {
-	log.out.Infof(format, args...)
+	writeFile(format, args...)
+	if log.json {
+		return
+	}
 	fmt.Fprintf(color.Output, "%s\n", blueString(format, args...))
 }
 
 // Fail prints a message with the error symbol first, and the text in red
 func Fail(format string, args ...interface{}) {
-	log.out.Infof(format, args...)
+	writeFile(format, args...)
+	if log.json {
+		return
+	}
 	fmt.Fprintf(color.Output, "%s %s\n", errorSymbol, redString(format, args...))
 }
 
 // Println writes a line with colors
 func Println(args ...interface{}) {
-	log.out.Info(args...)
+	if log.file != nil {
+		log.file.Info(args...)
+	}
+	if log.json {
+		return
+	}
 	fmt.Fprintln(color.Output, args...)
 }
+
+// EnableJSONOutput replaces the decorated text output by newline-delimited JSON events.
+// The logs are written to stderr, so stdout only contains the JSON events
+func EnableJSONOutput() {
+	log.json = true
+	log.out.SetOutput(os.Stderr)
+}
+
+// IsJSONOutput returns if the JSON output is enabled
+func IsJSONOutput() bool {
+	return log.json
+}
+
+// Emit writes an event as a JSON line when the JSON output is enabled
+func Emit(e *Event) {
+	if !log.json {
+		return
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		Infof("failed to marshal event '%s': %s", e.Event, err)
+		return
+	}
+	fmt.Fprintln(os.Stdout, string(b))
+}
@@ -16,6 +16,7 @@ package model
 import (
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
@@ -24,6 +25,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/okteto/okteto/pkg/log"
@@ -36,10 +38,23 @@ import (
 
 const (
 	oktetoMarkerPathVariable    = "OKTETO_MARKER_PATH"
+	syncthingFolderMarker       = ".stfolder"
 	oktetoSSHServerPortVariable = "OKTETO_REMOTE_PORT"
+	oktetoNamespaceVariable     = "OKTETO_NAMESPACE"
 	oktetoDefaultSSHServerPort  = 2222
 	//OktetoDefaultPVSize default volume size
 	OktetoDefaultPVSize = "2Gi"
+	//DefaultSyncRescanInterval default syncthing rescan interval in seconds
+	DefaultSyncRescanInterval = 300
+
+	//DefaultSyncStartThreshold default percentage of synchronized files required to start the development environment
+	DefaultSyncStartThreshold = 100
+
+	//DefaultShell default shell used to run the init commands and to clean the development container
+	DefaultShell = "sh"
+
+	//DefaultReadinessTimeout default time to wait for the readiness check to pass
+	DefaultReadinessTimeout = 60 * time.Second
 
 	//DeprecatedOktetoVolumeName name of the (deprecated) okteto persistent volume
 	DeprecatedOktetoVolumeName = "okteto"
@@ -47,10 +62,19 @@ const (
 	OktetoVolumeNameTemplate = "okteto-%s"
 	//SourceCodeSubPath subpath in the dev environment persistent volume for the source code
 	SourceCodeSubPath = "src"
+	//DockerSocketPath path of the docker socket in the node and in the development container
+	DockerSocketPath = "/var/run/docker.sock"
 	//OktetoSyncthingMountPath syncthing volume mount path
 	OktetoSyncthingMountPath = "/var/syncthing"
 	//SyncthingSubPath subpath in the dev environment persistent volume for the syncthing data
 	SyncthingSubPath = "syncthing"
+
+	//HistorySubPath subpath in the dev environment persistent volume for the shell history
+	HistorySubPath = "history"
+	//OktetoHistoryMountPath default shell history volume mount path
+	OktetoHistoryMountPath = "/var/okteto/history"
+	//historyFileName name of the shell history file
+	historyFileName = ".shell_history"
 	//OktetoAutoCreateAnnotation indicates if the deployment was auto generatted by okteto up
 	OktetoAutoCreateAnnotation = "dev.okteto.com/auto-create"
 	//OktetoRestartAnnotation indicates the dev pod must be recreated to pull the latest version of its image
@@ -96,17 +120,26 @@ type Dev struct {
 	Build                *BuildInfo            `json:"-" yaml:"build,omitempty"`
 	Push                 *BuildInfo            `json:"-" yaml:"push,omitempty"`
 	ImagePullPolicy      apiv1.PullPolicy      `json:"imagePullPolicy,omitempty" yaml:"imagePullPolicy,omitempty"`
+	ImagePullSecrets     []string              `json:"imagePullSecrets,omitempty" yaml:"imagePullSecrets,omitempty"`
 	Environment          []EnvVar              `json:"environment,omitempty" yaml:"environment,omitempty"`
+	EnvFrom              []EnvFrom             `json:"envFrom,omitempty" yaml:"envFrom,omitempty"`
 	Secrets              []Secret              `json:"secrets,omitempty" yaml:"secrets,omitempty"`
 	Command              []string              `json:"command,omitempty" yaml:"command,omitempty"`
+	InitCommands         []string              `json:"initCommands,omitempty" yaml:"initCommands,omitempty"`
+	Shell                string                `json:"shell,omitempty" yaml:"shell,omitempty"`
+	RestartOnExit        int                   `json:"restartOnExit,omitempty" yaml:"restartOnExit,omitempty"`
 	Healthchecks         bool                  `json:"healthchecks,omitempty" yaml:"healthchecks,omitempty"`
+	ReadinessCheck       *ReadinessCheck       `json:"readinessCheck,omitempty" yaml:"readinessCheck,omitempty"`
+	DockerSocket         bool                  `json:"dockerSocket,omitempty" yaml:"dockerSocket,omitempty"`
 	WorkDir              string                `json:"workdir,omitempty" yaml:"workdir,omitempty"`
 	MountPath            string                `json:"mountpath,omitempty" yaml:"mountpath,omitempty"`
 	SubPath              string                `json:"subpath,omitempty" yaml:"subpath,omitempty"`
 	PersistentVolumeInfo *PersistentVolumeInfo `json:"persistentVolume,omitempty" yaml:"persistentVolume,omitempty"`
+	History              *HistoryInfo          `json:"history,omitempty" yaml:"history,omitempty"`
 	Volumes              []Volume              `json:"volumes,omitempty" yaml:"volumes,omitempty"`
 	ExternalVolumes      []ExternalVolume      `json:"externalVolumes,omitempty" yaml:"externalVolumes,omitempty"`
 	SecurityContext      *SecurityContext      `json:"securityContext,omitempty" yaml:"securityContext,omitempty"`
+	Sync                 SyncInfo              `json:"sync,omitempty" yaml:"sync,omitempty"`
 	Forward              []Forward             `json:"forward,omitempty" yaml:"forward,omitempty"`
 	Reverse              []Reverse             `json:"reverse,omitempty" yaml:"reverse,omitempty"`
 	RemotePort           int                   `json:"remote,omitempty" yaml:"remote,omitempty"`
@@ -114,6 +147,7 @@ type Dev struct {
 	DevPath              string                `json:"-" yaml:"-"`
 	DevDir               string                `json:"-" yaml:"-"`
 	Services             []*Dev                `json:"services,omitempty" yaml:"services,omitempty"`
+	Service              *ServiceInfo          `json:"service,omitempty" yaml:"service,omitempty"`
 	SSHServerPort        int                   `json:"sshServerPort,omitempty" yaml:"sshServerPort,omitempty"`
 }
 
@@ -130,7 +164,33 @@ type BuildInfoRaw struct {
 	Args       []EnvVar `yaml:"args,omitempty"`
 }
 
-// Volume represents a volume in the dev environment
+// ReadinessCheck represents the check that okteto up polls to know that the application of the dev environment is ready
+type ReadinessCheck struct {
+	HTTP    *HTTPReadinessCheck `json:"http,omitempty" yaml:"http,omitempty"`
+	Command []string            `json:"command,omitempty" yaml:"command,omitempty"`
+	Timeout time.Duration       `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// HTTPReadinessCheck represents an http GET request to a port of the dev container
+type HTTPReadinessCheck struct {
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+	Port int    `json:"port" yaml:"port"`
+}
+
+// ServiceInfo represents the kubernetes service created for the dev environment
+type ServiceInfo struct {
+	Type        apiv1.ServiceType `json:"type,omitempty" yaml:"type,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+}
+
+// EnvFrom represents an existing Secret or ConfigMap used to populate the environment of the dev container
+type EnvFrom struct {
+	SecretRef    string `json:"secretRef,omitempty" yaml:"secretRef,omitempty"`
+	ConfigMapRef string `json:"configMapRef,omitempty" yaml:"configMapRef,omitempty"`
+}
+
+// Volume represents a volume in the dev environment.
+// MountPath is an absolute path in the dev container, it isn't relative to 'workdir' or 'mountpath'
 type Volume struct {
 	SubPath   string
 	MountPath string
@@ -150,6 +210,28 @@ type PersistentVolumeInfo struct {
 	Size         string `json:"size,omitempty" yaml:"size,omitempty"`
 }
 
+// HistoryInfo represents the persistent shell history configuration
+type HistoryInfo struct {
+	Enabled bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Path    string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// SyncInfo represents the file synchronization configuration
+type SyncInfo struct {
+	RescanInterval    int          `json:"rescanInterval,omitempty" yaml:"rescanInterval,omitempty"`
+	IgnorePermissions bool         `json:"ignorePermissions,omitempty" yaml:"ignorePermissions,omitempty"`
+	StartThreshold    int          `json:"startThreshold,omitempty" yaml:"startThreshold,omitempty"`
+	Folders           []SyncFolder `json:"folders,omitempty" yaml:"folders,omitempty"`
+}
+
+// SyncFolder represents a local folder synchronized to a path of the development container.
+// Read-only folders are sent to the development container, but remote changes are never synchronized back
+type SyncFolder struct {
+	LocalPath  string `json:"localPath" yaml:"localPath"`
+	RemotePath string `json:"remotePath,omitempty" yaml:"remotePath,omitempty"`
+	ReadOnly   bool   `json:"readOnly,omitempty" yaml:"readOnly,omitempty"`
+}
+
 // SecurityContext represents a pod security context
 type SecurityContext struct {
 	RunAsUser    *int64        `json:"runAsUser,omitempty" yaml:"runAsUser,omitempty"`
@@ -199,6 +281,31 @@ func Get(devPath string) (*Dev, error) {
 		return nil, err
 	}
 
+	devDir, err := filepath.Abs(filepath.Dir(devPath))
+	if err != nil {
+		return nil, err
+	}
+
+	return load(b, devDir, filepath.Base(devPath))
+}
+
+//GetFromReader returns a Dev object from a manifest read from r, relative to the current folder.
+//There is no manifest file to use as the synchronization marker, so the syncthing folder marker is used instead
+func GetFromReader(r io.Reader) (*Dev, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	devDir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	return load(b, devDir, syncthingFolderMarker)
+}
+
+func load(b []byte, devDir, devPath string) (*Dev, error) {
 	dev, err := Read(b)
 	if err != nil {
 		return nil, err
@@ -208,11 +315,12 @@ func Get(devPath string) (*Dev, error) {
 		return nil, err
 	}
 
-	dev.DevDir, err = filepath.Abs(filepath.Dir(devPath))
-	if err != nil {
+	dev.DevDir = devDir
+	dev.DevPath = devPath
+
+	if err := dev.validateSyncFoldersExist(); err != nil {
 		return nil, err
 	}
-	dev.DevPath = filepath.Base(devPath)
 
 	return dev, nil
 }
@@ -236,9 +344,7 @@ func Read(bytes []byte) (*Dev, error) {
 			_, _ = sb.WriteString("Invalid manifest:\n")
 			l := strings.Split(err.Error(), "\n")
 			for i := 1; i < len(l); i++ {
-				e := strings.TrimSuffix(l[i], "in type model.Dev")
-				e = strings.TrimSpace(e)
-				_, _ = sb.WriteString(fmt.Sprintf("    - %s\n", e))
+				_, _ = sb.WriteString(fmt.Sprintf("    - %s\n", formatUnmarshalError(l[i], bytes)))
 			}
 
 			_, _ = sb.WriteString("    See https://okteto.com/docs/reference/manifest for details")
@@ -256,6 +362,12 @@ func Read(bytes []byte) (*Dev, error) {
 		s.loadImage()
 	}
 
+	forwards, err := expandForwards(dev.Forward)
+	if err != nil {
+		return nil, err
+	}
+	dev.Forward = forwards
+
 	if err := dev.setDefaults(); err != nil {
 		return nil, err
 	}
@@ -283,6 +395,7 @@ func (dev *Dev) setDefaults() error {
 	}
 	setBuildDefaults(dev.Build)
 	setBuildDefaults(dev.Push)
+	dev.cleanRemotePaths()
 	if dev.MountPath == "" && dev.WorkDir == "" {
 		dev.MountPath = "/okteto"
 		dev.WorkDir = "/okteto"
@@ -302,8 +415,35 @@ func (dev *Dev) setDefaults() error {
 	if dev.SSHServerPort == 0 {
 		dev.SSHServerPort = oktetoDefaultSSHServerPort
 	}
+	if dev.Sync.RescanInterval == 0 {
+		dev.Sync.RescanInterval = DefaultSyncRescanInterval
+	}
+	if dev.Sync.StartThreshold == 0 {
+		dev.Sync.StartThreshold = DefaultSyncStartThreshold
+	}
+	if dev.Shell == "" {
+		dev.Shell = DefaultShell
+	}
+	if dev.ReadinessCheck != nil {
+		if dev.ReadinessCheck.Timeout == 0 {
+			dev.ReadinessCheck.Timeout = DefaultReadinessTimeout
+		}
+		if dev.ReadinessCheck.HTTP != nil && dev.ReadinessCheck.HTTP.Path == "" {
+			dev.ReadinessCheck.HTTP.Path = "/"
+		}
+	}
+	if dev.History != nil && dev.History.Path == "" {
+		dev.History.Path = OktetoHistoryMountPath
+	}
+	for i := range dev.Sync.Folders {
+		f := &dev.Sync.Folders[i]
+		if f.RemotePath == "" && f.LocalPath != "" && isDevDirSubPath(f.LocalPath) {
+			f.RemotePath = path.Join(dev.MountPath, filepath.ToSlash(f.LocalPath))
+		}
+	}
 	dev.setRunAsUserDefaults(dev)
 	for _, s := range dev.Services {
+		s.cleanRemotePaths()
 		if s.MountPath == "" && s.WorkDir == "" {
 			s.MountPath = "/okteto"
 			s.WorkDir = "/okteto"
@@ -342,6 +482,39 @@ func setBuildDefaults(build *BuildInfo) {
 	}
 }
 
+//cleanRemotePaths removes trailing slashes and '.' or '..' segments from the paths in the dev container.
+//Relative paths are kept relative so validate can reject them
+func (dev *Dev) cleanRemotePaths() {
+	dev.WorkDir = cleanRemotePath(dev.WorkDir)
+	dev.MountPath = cleanRemotePath(dev.MountPath)
+	for i := range dev.Volumes {
+		dev.Volumes[i].MountPath = cleanRemotePath(dev.Volumes[i].MountPath)
+	}
+	for i := range dev.Sync.Folders {
+		dev.Sync.Folders[i].RemotePath = cleanRemotePath(dev.Sync.Folders[i].RemotePath)
+	}
+}
+
+func cleanRemotePath(p string) string {
+	if p == "" {
+		return ""
+	}
+	return path.Clean(p)
+}
+
+func validateRemotePaths(dev *Dev) error {
+	if dev.WorkDir != "" && !path.IsAbs(dev.WorkDir) {
+		return fmt.Errorf("'workdir' must be an absolute path, like '/okteto'. Relative paths depend on the working directory of the image")
+	}
+	if !path.IsAbs(dev.MountPath) {
+		return fmt.Errorf("'mountpath' must be an absolute path, like '/okteto'")
+	}
+	if dev.MountPath == "/" {
+		return fmt.Errorf("'mountpath' cannot be '/'")
+	}
+	return nil
+}
+
 func (dev *Dev) setRunAsUserDefaults(main *Dev) {
 	if !main.PersistentVolumeEnabled() {
 		return
@@ -377,10 +550,26 @@ func (dev *Dev) validate() error {
 		return fmt.Errorf("'subpath' is not supported in the main dev container")
 	}
 
+	if err := validateRemotePaths(dev); err != nil {
+		return err
+	}
+
 	if err := validatePullPolicy(dev.ImagePullPolicy); err != nil {
 		return err
 	}
 
+	if err := validateEnvironment(dev.Environment); err != nil {
+		return err
+	}
+
+	if err := validateEnvFrom(dev.EnvFrom); err != nil {
+		return err
+	}
+
+	if err := validateSecurityContext(dev.SecurityContext); err != nil {
+		return err
+	}
+
 	if err := validateSecrets(dev.Secrets); err != nil {
 		return err
 	}
@@ -392,12 +581,33 @@ func (dev *Dev) validate() error {
 		if len(dev.Volumes) > 0 {
 			return fmt.Errorf("'persistentVolume.enabled' must be set to true to use volumes")
 		}
+		if dev.HistoryEnabled() {
+			return fmt.Errorf("'persistentVolume.enabled' must be set to true to persist the shell history")
+		}
+	}
+
+	if dev.HistoryEnabled() && !path.IsAbs(dev.History.Path) {
+		return fmt.Errorf("'history.path' must be an absolute path")
 	}
 
 	if err := validateVolumes(dev.Volumes); err != nil {
 		return err
 	}
 
+	if err := validateService(dev.Service); err != nil {
+		return err
+	}
+
+	for _, s := range dev.ImagePullSecrets {
+		if s == "" {
+			return fmt.Errorf("'imagePullSecrets' cannot contain empty names")
+		}
+	}
+
+	if err := validateReadinessCheck(dev.ReadinessCheck); err != nil {
+		return err
+	}
+
 	if err := validateExternalVolumes(dev.ExternalVolumes); err != nil {
 		return err
 	}
@@ -410,15 +620,84 @@ func (dev *Dev) validate() error {
 		if err := validatePullPolicy(s.ImagePullPolicy); err != nil {
 			return err
 		}
+		if err := validateEnvironment(s.Environment); err != nil {
+			return err
+		}
+		if err := validateEnvFrom(s.EnvFrom); err != nil {
+			return err
+		}
+		if err := validateSecurityContext(s.SecurityContext); err != nil {
+			return err
+		}
+		if err := validateRemotePaths(s); err != nil {
+			return err
+		}
+	}
+
+	if dev.DockerSocket {
+		log.Yellow("'dockerSocket' mounts the docker socket of the node in your development container, giving it full control of the node. Only enable it in clusters you trust")
+	}
+
+	for _, f := range dev.Forward {
+		if f.IsPrivileged() {
+			log.Yellow("Local port %d of port-forward '%s' is below %d and might require admin privileges", f.Local, f.String(), privilegedPortLimit)
+		}
 	}
 
 	if dev.SSHServerPort <= 0 {
 		return fmt.Errorf("'sshServerPort' must be > 0")
 	}
 
+	if dev.RestartOnExit < 0 {
+		return fmt.Errorf("'restartOnExit' must be >= 0")
+	}
+
+	if dev.Sync.RescanInterval < 0 {
+		return fmt.Errorf("'sync.rescanInterval' must be > 0")
+	}
+
+	if dev.Sync.StartThreshold < 1 || dev.Sync.StartThreshold > 100 {
+		return fmt.Errorf("'sync.startThreshold' must be between 1 and 100")
+	}
+
+	if err := validateSyncFolders(dev.MountPath, dev.Sync.Folders); err != nil {
+		return err
+	}
+
+	if dev.PersistentVolumeEnabled() {
+		for _, p := range dev.getNotPersistedSyncFolders() {
+			log.Yellow("'sync.folders' remotePath '%s' is not stored in your persistent volume, its files will be transferred again every time your development container restarts. Use a remotePath under '%s' or add it to 'volumes'", p, dev.MountPath)
+		}
+	}
+
 	return nil
 }
 
+//getNotPersistedSyncFolders returns the remote paths of the sync folders that are not under the mount path or a volume
+func (dev *Dev) getNotPersistedSyncFolders() []string {
+	persisted := []string{dev.MountPath}
+	for _, v := range dev.Volumes {
+		persisted = append(persisted, v.MountPath)
+	}
+
+	result := []string{}
+	for _, f := range dev.Sync.Folders {
+		remotePath := path.Clean(f.RemotePath)
+		found := false
+		for _, p := range persisted {
+			p = path.Clean(p)
+			if p == "/" || remotePath == p || strings.HasPrefix(remotePath, p+"/") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, f.RemotePath)
+		}
+	}
+	return result
+}
+
 func validatePullPolicy(pullPolicy apiv1.PullPolicy) error {
 	switch pullPolicy {
 	case apiv1.PullAlways:
@@ -430,6 +709,76 @@ func validatePullPolicy(pullPolicy apiv1.PullPolicy) error {
 	return nil
 }
 
+func validateService(service *ServiceInfo) error {
+	if service == nil {
+		return nil
+	}
+	switch service.Type {
+	case "":
+	case apiv1.ServiceTypeClusterIP:
+	case apiv1.ServiceTypeNodePort:
+	case apiv1.ServiceTypeLoadBalancer:
+	default:
+		return fmt.Errorf("supported values for 'service.type' are: 'ClusterIP', 'NodePort' or 'LoadBalancer'")
+	}
+	return nil
+}
+
+func validateReadinessCheck(rc *ReadinessCheck) error {
+	if rc == nil {
+		return nil
+	}
+	if (rc.HTTP == nil) == (len(rc.Command) == 0) {
+		return fmt.Errorf("'readinessCheck' must define either 'http' or 'command'")
+	}
+	if rc.HTTP != nil && (rc.HTTP.Port < 1 || rc.HTTP.Port > maxPort) {
+		return fmt.Errorf("'readinessCheck.http.port' must be between 1 and %d", maxPort)
+	}
+	if rc.Timeout < 0 {
+		return fmt.Errorf("'readinessCheck.timeout' must be >= 0")
+	}
+	return nil
+}
+
+func validateEnvironment(environment []EnvVar) error {
+	seen := map[string]bool{}
+	for _, e := range environment {
+		if e.Name == "" {
+			continue
+		}
+		if _, ok := seen[e.Name]; ok {
+			return fmt.Errorf("Environment variable '%s' is defined more than once", e.Name)
+		}
+		seen[e.Name] = true
+	}
+	return nil
+}
+
+func validateEnvFrom(envFrom []EnvFrom) error {
+	for _, e := range envFrom {
+		if (e.SecretRef == "") == (e.ConfigMapRef == "") {
+			return fmt.Errorf("each 'envFrom' entry must define either 'secretRef' or 'configMapRef'")
+		}
+	}
+	return nil
+}
+
+func validateSecurityContext(s *SecurityContext) error {
+	if s == nil {
+		return nil
+	}
+	if s.RunAsUser != nil && *s.RunAsUser < 0 {
+		return fmt.Errorf("'securityContext.runAsUser' must be >= 0")
+	}
+	if s.RunAsGroup != nil && *s.RunAsGroup < 0 {
+		return fmt.Errorf("'securityContext.runAsGroup' must be >= 0")
+	}
+	if s.FSGroup != nil && *s.FSGroup < 0 {
+		return fmt.Errorf("'securityContext.fsGroup' must be >= 0")
+	}
+	return nil
+}
+
 func validateSecrets(secrets []Secret) error {
 	seen := map[string]bool{}
 	for _, s := range secrets {
@@ -441,6 +790,97 @@ func validateSecrets(secrets []Secret) error {
 	return nil
 }
 
+func validateSyncFolders(mountPath string, folders []SyncFolder) error {
+	remotePaths := map[string]bool{path.Clean(mountPath): true}
+	for i, f := range folders {
+		if f.LocalPath == "" {
+			return fmt.Errorf("'sync.folders' localPath cannot be empty")
+		}
+		if isDevDirSubPath(f.LocalPath) && path.Clean(f.LocalPath) == "." {
+			return fmt.Errorf("'sync.folders' localPath '%s' cannot be the folder of your okteto manifest", f.LocalPath)
+		}
+		if f.RemotePath == "" {
+			return fmt.Errorf("'sync.folders' remotePath is required for localPath '%s'", f.LocalPath)
+		}
+		if !path.IsAbs(f.RemotePath) {
+			return fmt.Errorf("'sync.folders' remotePath '%s' must be an absolute path", f.RemotePath)
+		}
+		remotePath := path.Clean(f.RemotePath)
+		if remotePath == "/" {
+			return fmt.Errorf("'sync.folders' remotePath '/' is not supported")
+		}
+		if remotePaths[remotePath] {
+			return fmt.Errorf("'sync.folders' remotePath '%s' is defined more than once", f.RemotePath)
+		}
+		remotePaths[remotePath] = true
+
+		if !isDevDirSubPath(f.LocalPath) {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if !isDevDirSubPath(folders[j].LocalPath) || !isSubPath(folders[i].LocalPath, folders[j].LocalPath) {
+				continue
+			}
+			if folders[i].ReadOnly != folders[j].ReadOnly {
+				return fmt.Errorf("read-only path '%s' overlaps with writable path '%s'", getReadOnlyPath(folders[i], folders[j]), getWritablePath(folders[i], folders[j]))
+			}
+			return fmt.Errorf("'sync.folders' localPath '%s' overlaps with localPath '%s'", folders[i].LocalPath, folders[j].LocalPath)
+		}
+	}
+	return nil
+}
+
+//validateSyncFoldersExist checks that the local paths of the sync folders exist
+func (dev *Dev) validateSyncFoldersExist() error {
+	for _, f := range dev.Sync.Folders {
+		localPath := f.LocalPath
+		if !filepath.IsAbs(localPath) {
+			localPath = filepath.Join(dev.DevDir, filepath.FromSlash(localPath))
+		}
+		info, err := os.Stat(localPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("'sync.folders' localPath '%s' does not exist", f.LocalPath)
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("'sync.folders' localPath '%s' is not a folder", f.LocalPath)
+		}
+	}
+	return nil
+}
+
+//isDevDirSubPath returns if a local path is relative to the folder of the okteto manifest and doesn't leave it
+func isDevDirSubPath(p string) bool {
+	if filepath.IsAbs(p) || path.IsAbs(p) {
+		return false
+	}
+	p = path.Clean(filepath.ToSlash(p))
+	return p != ".." && !strings.HasPrefix(p, "../")
+}
+
+//isSubPath returns if one of the paths is equal or a subfolder of the other one
+func isSubPath(a, b string) bool {
+	a = path.Clean(filepath.ToSlash(a))
+	b = path.Clean(filepath.ToSlash(b))
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+func getReadOnlyPath(a, b SyncFolder) string {
+	if a.ReadOnly {
+		return a.LocalPath
+	}
+	return b.LocalPath
+}
+
+func getWritablePath(a, b SyncFolder) string {
+	if a.ReadOnly {
+		return b.LocalPath
+	}
+	return a.LocalPath
+}
+
 func validateVolumes(vList []Volume) error {
 	for _, v := range vList {
 		if !strings.HasPrefix(v.MountPath, "/") {
@@ -470,12 +910,12 @@ func (dev *Dev) LoadRemote(pubKeyPath string) {
 	if dev.RemotePort == 0 {
 		p, err := GetAvailablePort()
 		if err != nil {
-			log.Infof("failed to get random port for SSH connection: %s", err)
+			log.Debugf("failed to get random port for SSH connection: %s", err)
 			p = 2222
 		}
 
 		dev.RemotePort = p
-		log.Infof("remote port not set, using %d", dev.RemotePort)
+		log.Debugf("remote port not set, using %d", dev.RemotePort)
 	}
 
 	p := Secret{
@@ -484,7 +924,7 @@ func (dev *Dev) LoadRemote(pubKeyPath string) {
 		Mode:       0600,
 	}
 
-	log.Infof("enabled remote mode")
+	log.Debugf("enabled remote mode")
 
 	for i := range dev.Secrets {
 		if dev.Secrets[i].LocalPath == p.LocalPath {
@@ -504,19 +944,19 @@ func (dev *Dev) LoadForcePull() {
 		s.ImagePullPolicy = apiv1.PullAlways
 		s.Annotations[OktetoRestartAnnotation] = restartUUID
 	}
-	log.Infof("enabled force pull")
+	log.Debugf("enabled force pull")
 }
 
 //Save saves the okteto manifest in a given path
 func (dev *Dev) Save(path string) error {
 	marshalled, err := yaml.Marshal(dev)
 	if err != nil {
-		log.Infof("failed to marshall dev environment: %s", err)
+		log.Debugf("failed to marshall dev environment: %s", err)
 		return fmt.Errorf("Failed to generate your manifest")
 	}
 
 	if err := ioutil.WriteFile(path, marshalled, 0600); err != nil {
-		log.Info(err)
+		log.Debug(err)
 		return fmt.Errorf("Failed to write your manifest")
 	}
 
@@ -573,6 +1013,7 @@ func (dev *Dev) ToTranslationRule(main *Dev) *TranslationRule {
 		Image:            dev.Image,
 		ImagePullPolicy:  dev.ImagePullPolicy,
 		Environment:      dev.Environment,
+		EnvFrom:          dev.EnvFrom,
 		Secrets:          dev.Secrets,
 		WorkDir:          dev.WorkDir,
 		PersistentVolume: main.PersistentVolumeEnabled(),
@@ -580,6 +1021,8 @@ func (dev *Dev) ToTranslationRule(main *Dev) *TranslationRule {
 		SecurityContext:  dev.SecurityContext,
 		Resources:        dev.Resources,
 		Healthchecks:     dev.Healthchecks,
+		DockerSocket:     dev.DockerSocket,
+		ImagePullSecrets: dev.ImagePullSecrets,
 	}
 
 	if main.PersistentVolumeEnabled() {
@@ -635,6 +1078,23 @@ func (dev *Dev) ToTranslationRule(main *Dev) *TranslationRule {
 				SubPath:   SyncthingSubPath,
 			},
 		)
+		if dev.HistoryEnabled() {
+			rule.Volumes = append(
+				rule.Volumes,
+				VolumeMount{
+					Name:      main.GetVolumeName(),
+					MountPath: dev.History.Path,
+					SubPath:   HistorySubPath,
+				},
+			)
+			rule.Environment = append(
+				rule.Environment,
+				EnvVar{
+					Name:  "HISTFILE",
+					Value: path.Join(dev.History.Path, historyFileName),
+				},
+			)
+		}
 		rule.Command = []string{"/var/okteto/bin/start.sh"}
 		if main.RemoteModeEnabled() {
 			rule.Args = []string{"-r"}
@@ -680,15 +1140,34 @@ func (dev *Dev) ToTranslationRule(main *Dev) *TranslationRule {
 	return rule
 }
 
-//UpdateNamespace updates the dev namespace
+//UpdateNamespace updates the dev namespace.
+//The precedence is: the --namespace flag, the OKTETO_NAMESPACE env var, the okteto manifest and the kubernetes context.
+//The flag and the env var must match the namespace of the okteto manifest when it is set
 func (dev *Dev) UpdateNamespace(namespace string) error {
-	if namespace == "" {
+	if namespace != "" {
+		if dev.Namespace != "" && dev.Namespace != namespace {
+			return fmt.Errorf("the namespace in the okteto manifest '%s' does not match the namespace '%s'", dev.Namespace, namespace)
+		}
+		log.Debugf("using namespace '%s' from the --namespace flag", namespace)
+		dev.Namespace = namespace
 		return nil
 	}
-	if dev.Namespace != "" && dev.Namespace != namespace {
-		return fmt.Errorf("the namespace in the okteto manifest '%s' does not match the namespace '%s'", dev.Namespace, namespace)
+
+	if env := os.Getenv(oktetoNamespaceVariable); env != "" {
+		if dev.Namespace != "" && dev.Namespace != env {
+			return fmt.Errorf("the namespace in the okteto manifest '%s' does not match the namespace '%s' of the %s env var", dev.Namespace, env, oktetoNamespaceVariable)
+		}
+		log.Debugf("using namespace '%s' from the %s env var", env, oktetoNamespaceVariable)
+		dev.Namespace = env
+		return nil
+	}
+
+	if dev.Namespace != "" {
+		log.Debugf("using namespace '%s' from the okteto manifest", dev.Namespace)
+		return nil
 	}
-	dev.Namespace = namespace
+
+	log.Debugf("using the namespace of the current kubernetes context")
 	return nil
 }
 
@@ -698,6 +1177,10 @@ func (dev *Dev) GevSandbox() *appsv1.Deployment {
 	if image == "" {
 		image = DefaultImage
 	}
+	pullSecrets := []apiv1.LocalObjectReference{}
+	for _, name := range dev.ImagePullSecrets {
+		pullSecrets = append(pullSecrets, apiv1.LocalObjectReference{Name: name})
+	}
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dev.Name,
@@ -724,6 +1207,7 @@ func (dev *Dev) GevSandbox() *appsv1.Deployment {
 				},
 				Spec: apiv1.PodSpec{
 					TerminationGracePeriodSeconds: &devTerminationGracePeriodSeconds,
+					ImagePullSecrets:              pullSecrets,
 					Containers: []apiv1.Container{
 						{
 							Name:            "dev",
@@ -778,6 +1262,14 @@ func (dev *Dev) PersistentVolumeEnabled() bool {
 	return dev.PersistentVolumeInfo.Enabled
 }
 
+// HistoryEnabled returns true if the shell history is persisted for dev
+func (dev *Dev) HistoryEnabled() bool {
+	if dev.History == nil {
+		return false
+	}
+	return dev.History.Enabled
+}
+
 // PersistentVolumeSize returns the persistent volume size
 func (dev *Dev) PersistentVolumeSize() string {
 	if dev.PersistentVolumeInfo == nil {
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path"
 	"reflect"
+	"strings"
 	"testing"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -142,6 +143,131 @@ workdir: /app`)
 	}
 }
 
+func Test_GetFromReader(t *testing.T) {
+	manifest := `
+name: deployment
+image: okteto/golang:1`
+
+	dev, err := GetFromReader(strings.NewReader(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dev.DevDir != wd {
+		t.Errorf("wrong dev dir: '%s'", dev.DevDir)
+	}
+
+	if dev.DevPath != syncthingFolderMarker {
+		t.Errorf("wrong dev path: '%s'", dev.DevPath)
+	}
+
+	if _, err := GetFromReader(strings.NewReader("name: Deployment")); err == nil {
+		t.Error("expected validation error")
+	}
+}
+
+func Test_remotePaths(t *testing.T) {
+	var tests = []struct {
+		name              string
+		manifest          string
+		expectedWorkDir   string
+		expectedMountPath string
+		expectedVolume    string
+		expectErr         bool
+	}{
+		{
+			name:              "defaults",
+			manifest:          "name: deployment",
+			expectedWorkDir:   "/okteto",
+			expectedMountPath: "/okteto",
+		},
+		{
+			name:              "trailing-slashes",
+			manifest:          "name: deployment\nworkdir: /app/\nmountpath: /app/src//\npersistentVolume:\n  enabled: true\nvolumes:\n  - /go/pkg/",
+			expectedWorkDir:   "/app",
+			expectedMountPath: "/app/src",
+			expectedVolume:    "/go/pkg",
+		},
+		{
+			name:              "dot-segments",
+			manifest:          "name: deployment\nworkdir: /app/./src/../code",
+			expectedWorkDir:   "/app/code",
+			expectedMountPath: "/app/code",
+		},
+		{
+			name:              "mountpath-only",
+			manifest:          "name: deployment\nmountpath: /app/",
+			expectedMountPath: "/app",
+		},
+		{
+			name:              "service-mountpath-only",
+			manifest:          "name: deployment\npersistentVolume:\n  enabled: true\nservices:\n  - name: worker\n    mountpath: /src",
+			expectedWorkDir:   "/okteto",
+			expectedMountPath: "/okteto",
+		},
+		{
+			name:      "service-relative-workdir",
+			manifest:  "name: deployment\npersistentVolume:\n  enabled: true\nservices:\n  - name: worker\n    workdir: src",
+			expectErr: true,
+		},
+		{
+			name:      "relative-workdir",
+			manifest:  "name: deployment\nworkdir: app",
+			expectErr: true,
+		},
+		{
+			name:      "relative-dot-workdir",
+			manifest:  "name: deployment\nworkdir: ./app",
+			expectErr: true,
+		},
+		{
+			name:      "relative-mountpath",
+			manifest:  "name: deployment\nworkdir: /app\nmountpath: src",
+			expectErr: true,
+		},
+		{
+			name:      "root-mountpath",
+			manifest:  "name: deployment\nworkdir: /app\nmountpath: /app/..",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev, err := Read([]byte(tt.manifest))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = dev.validate()
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected validation error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if dev.WorkDir != tt.expectedWorkDir {
+				t.Errorf("expected workdir '%s', got '%s'", tt.expectedWorkDir, dev.WorkDir)
+			}
+			if dev.MountPath != tt.expectedMountPath {
+				t.Errorf("expected mountpath '%s', got '%s'", tt.expectedMountPath, dev.MountPath)
+			}
+			if tt.expectedVolume != "" && dev.Volumes[0].MountPath != tt.expectedVolume {
+				t.Errorf("expected volume '%s', got '%s'", tt.expectedVolume, dev.Volumes[0].MountPath)
+			}
+		})
+	}
+}
+
 func Test_LoadDevDefaults(t *testing.T) {
 	var tests = []struct {
 		name                string
@@ -229,6 +355,18 @@ forward:
 			if d.PersistentVolumeEnabled() {
 				t.Errorf("peristent volume was enabled by default")
 			}
+
+			if d.Sync.RescanInterval != DefaultSyncRescanInterval {
+				t.Errorf("sync.rescanInterval was not defaulted: %d", d.Sync.RescanInterval)
+			}
+
+			if d.Sync.StartThreshold != DefaultSyncStartThreshold {
+				t.Errorf("sync.startThreshold was not defaulted: %d", d.Sync.StartThreshold)
+			}
+
+			if d.Shell != DefaultShell {
+				t.Errorf("shell was not defaulted: %s", d.Shell)
+			}
 		})
 	}
 }
@@ -682,6 +820,278 @@ func Test_validate(t *testing.T) {
       sshServerPort: -1`),
 			expectErr: true,
 		},
+		{
+			name: "duplicated-environment",
+			manifest: []byte(`
+      name: deployment
+      environment:
+        - ENV=production
+        - ENV=development`),
+			expectErr: true,
+		},
+		{
+			name: "valid-sync-folders",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        folders:
+          - localPath: src
+          - localPath: vendor
+            readOnly: true
+          - localPath: ../lib
+            remotePath: /usr/src/lib`),
+			expectErr: false,
+		},
+		{
+			name: "overlapping-read-only-sync-folder",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        folders:
+          - localPath: vendor
+          - localPath: vendor/github.com
+            readOnly: true`),
+			expectErr: true,
+		},
+		{
+			name: "sync-folder-outside-without-remote-path",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        folders:
+          - localPath: ../lib`),
+			expectErr: true,
+		},
+		{
+			name: "relative-sync-folder-remote-path",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        folders:
+          - localPath: ../lib
+            remotePath: lib`),
+			expectErr: true,
+		},
+		{
+			name: "duplicated-sync-folder-remote-path",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        folders:
+          - localPath: ../lib
+            remotePath: /usr/src/lib
+          - localPath: ../other
+            remotePath: /usr/src/lib`),
+			expectErr: true,
+		},
+		{
+			name: "sync-folder-remote-path-is-mount-path",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        folders:
+          - localPath: ../lib
+            remotePath: /okteto`),
+			expectErr: true,
+		},
+		{
+			name: "history-without-persistent-volume",
+			manifest: []byte(`
+      name: deployment
+      history:
+        enabled: true`),
+			expectErr: true,
+		},
+		{
+			name: "relative-history-path",
+			manifest: []byte(`
+      name: deployment
+      persistentVolume:
+        enabled: true
+      history:
+        enabled: true
+        path: history`),
+			expectErr: true,
+		},
+		{
+			name: "negative-restart-on-exit",
+			manifest: []byte(`
+      name: deployment
+      restartOnExit: -1`),
+			expectErr: true,
+		},
+		{
+			name: "valid-rescan-interval",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        rescanInterval: 30`),
+			expectErr: false,
+		},
+		{
+			name: "invalid-rescan-interval",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        rescanInterval: -1`),
+			expectErr: true,
+		},
+		{
+			name: "valid-start-threshold",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        startThreshold: 95`),
+			expectErr: false,
+		},
+		{
+			name: "invalid-start-threshold",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        startThreshold: 101`),
+			expectErr: true,
+		},
+		{
+			name: "negative-start-threshold",
+			manifest: []byte(`
+      name: deployment
+      sync:
+        startThreshold: -5`),
+			expectErr: true,
+		},
+		{
+			name: "valid-service",
+			manifest: []byte(`
+      name: deployment
+      service:
+        type: LoadBalancer
+        annotations:
+          service.beta.kubernetes.io/aws-load-balancer-internal: "true"`),
+			expectErr: false,
+		},
+		{
+			name: "http-readiness-check",
+			manifest: []byte(`
+      name: deployment
+      readinessCheck:
+        http:
+          path: /healthz
+          port: 8080
+        timeout: 2m`),
+			expectErr: false,
+		},
+		{
+			name: "command-readiness-check",
+			manifest: []byte(`
+      name: deployment
+      readinessCheck:
+        command: ["cat", "/tmp/ready"]`),
+			expectErr: false,
+		},
+		{
+			name: "empty-readiness-check",
+			manifest: []byte(`
+      name: deployment
+      readinessCheck:
+        timeout: 2m`),
+			expectErr: true,
+		},
+		{
+			name: "http-and-command-readiness-check",
+			manifest: []byte(`
+      name: deployment
+      readinessCheck:
+        http:
+          port: 8080
+        command: ["cat", "/tmp/ready"]`),
+			expectErr: true,
+		},
+		{
+			name: "readiness-check-bad-port",
+			manifest: []byte(`
+      name: deployment
+      readinessCheck:
+        http:
+          port: 0`),
+			expectErr: true,
+		},
+		{
+			name: "security-context",
+			manifest: []byte(`
+      name: deployment
+      securityContext:
+        runAsUser: 1000
+        runAsGroup: 1000
+        fsGroup: 1000`),
+			expectErr: false,
+		},
+		{
+			name: "negative-run-as-user",
+			manifest: []byte(`
+      name: deployment
+      securityContext:
+        runAsUser: -1`),
+			expectErr: true,
+		},
+		{
+			name: "negative-fs-group",
+			manifest: []byte(`
+      name: deployment
+      securityContext:
+        fsGroup: -1`),
+			expectErr: true,
+		},
+		{
+			name: "env-from",
+			manifest: []byte(`
+      name: deployment
+      envFrom:
+        - secretRef: db
+        - configMapRef: config`),
+			expectErr: false,
+		},
+		{
+			name: "env-from-both-refs",
+			manifest: []byte(`
+      name: deployment
+      envFrom:
+        - secretRef: db
+          configMapRef: config`),
+			expectErr: true,
+		},
+		{
+			name: "env-from-empty",
+			manifest: []byte(`
+      name: deployment
+      envFrom:
+        - {}`),
+			expectErr: true,
+		},
+		{
+			name: "image-pull-secrets",
+			manifest: []byte(`
+      name: deployment
+      imagePullSecrets:
+        - registry`),
+			expectErr: false,
+		},
+		{
+			name: "empty-image-pull-secret",
+			manifest: []byte(`
+      name: deployment
+      imagePullSecrets:
+        - ""`),
+			expectErr: true,
+		},
+		{
+			name: "invalid-service-type",
+			manifest: []byte(`
+      name: deployment
+      service:
+        type: ExternalName`),
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -813,3 +1223,126 @@ func Test_fullGlobalSubPath(t *testing.T) {
 		})
 	}
 }
+
+func TestDev_ReadInitCommands(t *testing.T) {
+	manifest := []byte(`name: deployment
+image: okteto/test
+initCommands:
+  - mkdir -p /data
+  - chown -R 1000 /data`)
+	dev, err := Read(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"mkdir -p /data", "chown -R 1000 /data"}
+	if !reflect.DeepEqual(dev.InitCommands, expected) {
+		t.Errorf("expected %v, got %v", expected, dev.InitCommands)
+	}
+}
+
+func TestDev_ReadSyncFolders(t *testing.T) {
+	manifest := []byte(`name: deployment
+image: okteto/test
+workdir: /app
+sync:
+  folders:
+    - localPath: vendor
+      readOnly: true
+    - localPath: ../lib
+      remotePath: /usr/src/lib`)
+	dev, err := Read(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []SyncFolder{
+		{LocalPath: "vendor", RemotePath: "/app/vendor", ReadOnly: true},
+		{LocalPath: "../lib", RemotePath: "/usr/src/lib"},
+	}
+	if !reflect.DeepEqual(dev.Sync.Folders, expected) {
+		t.Errorf("expected %+v, got %+v", expected, dev.Sync.Folders)
+	}
+}
+
+func TestDev_getNotPersistedSyncFolders(t *testing.T) {
+	dev := &Dev{
+		MountPath: "/okteto",
+		Volumes:   []Volume{{MountPath: "/root/.cache"}},
+		Sync: SyncInfo{
+			Folders: []SyncFolder{
+				{LocalPath: "vendor", RemotePath: "/okteto/vendor"},
+				{LocalPath: "../lib", RemotePath: "/usr/src/lib"},
+				{LocalPath: "../cache", RemotePath: "/root/.cache/lib"},
+				{LocalPath: "../other", RemotePath: "/oktetoother"},
+			},
+		},
+	}
+	expected := []string{"/usr/src/lib", "/oktetoother"}
+	if got := dev.getNotPersistedSyncFolders(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestDev_validateSyncFoldersExist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.Mkdir(path.Join(dir, "vendor"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	dev := &Dev{DevDir: dir, Sync: SyncInfo{Folders: []SyncFolder{{LocalPath: "vendor"}}}}
+	if err := dev.validateSyncFoldersExist(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	dev.Sync.Folders = append(dev.Sync.Folders, SyncFolder{LocalPath: "lib"})
+	if err := dev.validateSyncFoldersExist(); err == nil {
+		t.Error("expected error for a missing local path")
+	}
+}
+
+func TestDev_UpdateNamespace(t *testing.T) {
+	var tests = []struct {
+		name      string
+		manifest  string
+		flag      string
+		env       string
+		expected  string
+		expectErr bool
+	}{
+		{name: "kube-context", expected: ""},
+		{name: "manifest", manifest: "manifest", expected: "manifest"},
+		{name: "env", env: "env", expected: "env"},
+		{name: "env-and-manifest", manifest: "manifest", env: "env", expectErr: true},
+		{name: "env-matches-manifest", manifest: "manifest", env: "manifest", expected: "manifest"},
+		{name: "flag", env: "env", flag: "flag", expected: "flag"},
+		{name: "flag-and-manifest", manifest: "manifest", flag: "flag", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv(oktetoNamespaceVariable, tt.env)
+			defer os.Unsetenv(oktetoNamespaceVariable)
+
+			dev := &Dev{Namespace: tt.manifest}
+			err := dev.UpdateNamespace(tt.flag)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("didn't get the expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dev.Namespace != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, dev.Namespace)
+			}
+		})
+	}
+}
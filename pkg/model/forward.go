@@ -19,62 +19,261 @@ import (
 	"strings"
 )
 
-const malformedPortForward = "Wrong port-forward syntax '%s', must be of the form 'localPort:remotePort' or 'localPort:serviceName:remotePort'"
+const (
+	malformedPortForward = "Wrong port-forward syntax '%s', must be of the form 'localPort:remotePort', 'localPort:serviceName:remotePort' or 'localStart-localEnd:remoteStart-remoteEnd'"
+	maxPort              = 65535
+	privilegedPortLimit  = 1024
+)
 
 // Forward represents a port forwarding definition
 type Forward struct {
+	Name        string
 	Local       int
 	Remote      int
 	Service     bool   `json:"-" yaml:"-"`
 	ServiceName string `json:"-" yaml:"-"`
+
+	// rangeSize is the number of ports of a port range forward, it's expanded into single port forwards by expandForwards
+	rangeSize int
+}
+
+// forwardRaw represents the extended syntax of a port forwarding definition
+type forwardRaw struct {
+	Name     string `yaml:"name,omitempty"`
+	Local    int    `yaml:"local"`
+	Remote   int    `yaml:"remote"`
+	Service  string `yaml:"service,omitempty"`
+	Protocol string `yaml:"protocol,omitempty"`
+}
+
+// String returns the extended syntax of the port forwarding definition, used to identify it in error messages
+func (r forwardRaw) String() string {
+	s := fmt.Sprintf("local: %d, remote: %d", r.Local, r.Remote)
+	if r.Service != "" {
+		s = fmt.Sprintf("%s, service: %s", s, r.Service)
+	}
+	if r.Name != "" {
+		s = fmt.Sprintf("name: %s, %s", r.Name, s)
+	}
+	return fmt.Sprintf("{%s}", s)
 }
 
 // UnmarshalYAML Implements the Unmarshaler interface of the yaml pkg for port forwards.
 // It supports the following options:
 // - int:int
 // - int:serviceName:int
+// - int-int:int-int
+// - int-int:serviceName:int-int
+// - {name: string, local: int, remote: int, service: string, protocol: string}
+// The short syntax accepts an optional '/protocol' suffix. Only 'tcp' is supported.
 // Anything else will result in an error
 func (f *Forward) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var raw string
 	err := unmarshal(&raw)
 	if err != nil {
-		return err
+		return f.unmarshalExtended(unmarshal)
+	}
+
+	spec := raw
+	if i := strings.LastIndex(raw, "/"); i >= 0 {
+		if raw[i+1:] == "" {
+			return fmt.Errorf(malformedPortForward, raw)
+		}
+		if err := validateProtocol(raw[i+1:], raw); err != nil {
+			return err
+		}
+		spec = raw[:i]
 	}
 
-	parts := strings.Split(raw, ":")
+	parts := strings.Split(spec, ":")
 	if len(parts) < 2 || len(parts) > 3 {
 		return fmt.Errorf(malformedPortForward, raw)
 	}
 
-	localPort, err := strconv.Atoi(parts[0])
+	localStart, localEnd, err := parsePortRange(parts[0])
 	if err != nil {
 		return fmt.Errorf("Cannot convert local port '%s' in port-forward '%s'", parts[0], raw)
 	}
-	f.Local = localPort
+	f.Local = localStart
 
-	if len(parts) == 2 {
-		p, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return fmt.Errorf(malformedPortForward, raw)
+	remote := parts[1]
+	if len(parts) == 3 {
+		f.Service = true
+		f.ServiceName = parts[1]
+		remote = parts[2]
+	}
+
+	remoteStart, remoteEnd, err := parsePortRange(remote)
+	if err != nil {
+		return fmt.Errorf(malformedPortForward, raw)
+	}
+	f.Remote = remoteStart
+
+	if localEnd < localStart || remoteEnd < remoteStart {
+		return fmt.Errorf("Reversed port range in port-forward '%s', the first port must be lower than the last one", raw)
+	}
+
+	if localEnd-localStart != remoteEnd-remoteStart {
+		return fmt.Errorf("Local and remote port ranges of port-forward '%s' must have the same length", raw)
+	}
+
+	if localEnd > localStart {
+		f.rangeSize = localEnd - localStart + 1
+		last := &Forward{Local: localEnd, Remote: remoteEnd}
+		if err := last.validatePorts(raw); err != nil {
+			return err
 		}
+	}
 
-		f.Remote = p
-		return nil
+	return f.validatePorts(raw)
+}
+
+//parsePortRange parses a port or a range of ports of the form 'start-end'
+func parsePortRange(s string) (int, int, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("malformed port range '%s'", s)
 	}
 
-	f.Service = true
-	f.ServiceName = parts[1]
-	p, err := strconv.Atoi(parts[2])
+	start, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return fmt.Errorf(malformedPortForward, raw)
+		return 0, 0, err
+	}
+
+	if len(parts) == 1 {
+		return start, start, nil
+	}
+
+	end, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return start, end, nil
+}
+
+//expandForwards replaces the port range forwards by a forward for each port of the range,
+//and checks that every local port is only used once
+func expandForwards(forwards []Forward) ([]Forward, error) {
+	result := []Forward{}
+	used := map[int]string{}
+	for _, f := range forwards {
+		size := f.rangeSize
+		if size == 0 {
+			size = 1
+		}
+
+		for i := 0; i < size; i++ {
+			e := Forward{
+				Name:        f.Name,
+				Local:       f.Local + i,
+				Remote:      f.Remote + i,
+				Service:     f.Service,
+				ServiceName: f.ServiceName,
+			}
+
+			if previous, ok := used[e.Local]; ok {
+				return nil, fmt.Errorf("Local port %d is used by port-forwards '%s' and '%s'", e.Local, previous, e.String())
+			}
+
+			used[e.Local] = e.String()
+			result = append(result, e)
+		}
+	}
+
+	return result, nil
+}
+
+func (f *Forward) unmarshalExtended(unmarshal func(interface{}) error) error {
+	var keys map[string]interface{}
+	if err := unmarshal(&keys); err != nil {
+		return fmt.Errorf("Wrong port-forward syntax, must be of the form 'localPort:remotePort' or '{name: name, local: localPort, remote: remotePort}'")
+	}
+
+	for k := range keys {
+		switch k {
+		case "name", "local", "remote", "service", "protocol":
+		default:
+			return fmt.Errorf("Unknown field '%s' in port-forward, supported fields are 'name', 'local', 'remote', 'service' and 'protocol'", k)
+		}
+	}
+
+	var raw forwardRaw
+	if err := unmarshal(&raw); err != nil {
+		return err
 	}
 
-	f.Remote = p
+	if raw.Local <= 0 {
+		return fmt.Errorf("Port-forward '%s' must define a valid 'local' port", raw)
+	}
+
+	if raw.Remote <= 0 {
+		return fmt.Errorf("Port-forward '%s' must define a valid 'remote' port", raw)
+	}
+
+	if err := validateProtocol(raw.Protocol, raw.String()); err != nil {
+		return err
+	}
+
+	f.Name = raw.Name
+	f.Local = raw.Local
+	f.Remote = raw.Remote
+	if raw.Service != "" {
+		f.Service = true
+		f.ServiceName = raw.Service
+	}
+	return f.validatePorts(raw.String())
+}
+
+//validateProtocol checks that the protocol of a port-forward is supported.
+//Kubernetes port-forwarding only supports TCP, so UDP port-forwards are rejected instead of creating a broken TCP port-forward
+func validateProtocol(protocol, raw string) error {
+	switch strings.ToLower(protocol) {
+	case "", "tcp":
+		return nil
+	case "udp":
+		return fmt.Errorf("UDP port-forward '%s' is not supported yet, kubernetes port-forwarding only supports TCP", raw)
+	default:
+		return fmt.Errorf("Unsupported protocol '%s' in port-forward '%s', the only supported protocol is 'tcp'", protocol, raw)
+	}
+}
+
+//validatePorts checks that the ports of the port-forward are in the valid range
+func (f *Forward) validatePorts(raw string) error {
+	if f.Local < 1 || f.Local > maxPort {
+		return fmt.Errorf("Invalid local port %d in port-forward '%s', it must be between 1 and %d", f.Local, raw, maxPort)
+	}
+	if f.Remote < 1 || f.Remote > maxPort {
+		return fmt.Errorf("Invalid remote port %d in port-forward '%s', it must be between 1 and %d", f.Remote, raw, maxPort)
+	}
 	return nil
 }
 
+//IsPrivileged returns true if the local port of the port-forward usually requires admin privileges
+func (f *Forward) IsPrivileged() bool {
+	return f.Local < privilegedPortLimit
+}
+
 // MarshalYAML Implements the marshaler interface of the yaml pkg.
+// Named port-forwards use the extended syntax, and port range forwards keep their range
 func (f Forward) MarshalYAML() (interface{}, error) {
+	if f.Name != "" {
+		raw := forwardRaw{Name: f.Name, Local: f.Local, Remote: f.Remote}
+		if f.Service {
+			raw.Service = f.ServiceName
+		}
+		return raw, nil
+	}
+
+	if f.rangeSize > 1 {
+		local := fmt.Sprintf("%d-%d", f.Local, f.Local+f.rangeSize-1)
+		remote := fmt.Sprintf("%d-%d", f.Remote, f.Remote+f.rangeSize-1)
+		if f.Service {
+			return fmt.Sprintf("%s:%s:%s", local, f.ServiceName, remote), nil
+		}
+		return fmt.Sprintf("%s:%s", local, remote), nil
+	}
+
 	return f.String(), nil
 }
 
@@ -37,6 +37,26 @@ func TestForward_MarshalYAML(t *testing.T) {
 			expected: "8080:svc:5214",
 			data:     Forward{Local: 8080, Remote: 5214, Service: true, ServiceName: "svc"},
 		},
+		{
+			name:     "named",
+			expected: "name: api\nlocal: 8080\nremote: 80",
+			data:     Forward{Name: "api", Local: 8080, Remote: 80},
+		},
+		{
+			name:     "named-service",
+			expected: "name: db\nlocal: 5432\nremote: 5432\nservice: postgres",
+			data:     Forward{Name: "db", Local: 5432, Remote: 5432, Service: true, ServiceName: "postgres"},
+		},
+		{
+			name:     "range",
+			expected: "9000-9002:8000-8002",
+			data:     Forward{Local: 9000, Remote: 8000, rangeSize: 3},
+		},
+		{
+			name:     "service-range",
+			expected: "9000-9002:svc:8000-8002",
+			data:     Forward{Local: 9000, Remote: 8000, Service: true, ServiceName: "svc", rangeSize: 3},
+		},
 	}
 
 	for _, tt := range tests {
@@ -78,6 +98,16 @@ func TestForward_UnmarshalYAML(t *testing.T) {
 			expectErr: false,
 			expected:  Forward{Local: 8080, Remote: 5214, Service: true, ServiceName: "svc"},
 		},
+		{
+			name:     "range",
+			data:     "9000-9002:8000-8002",
+			expected: Forward{Local: 9000, Remote: 8000, rangeSize: 3},
+		},
+		{
+			name:     "service-range",
+			data:     "9000-9002:svc:8000-8002",
+			expected: Forward{Local: 9000, Remote: 8000, Service: true, ServiceName: "svc", rangeSize: 3},
+		},
 		{
 			name:      "bad-local-port",
 			data:      "local:8080",
@@ -108,6 +138,41 @@ func TestForward_UnmarshalYAML(t *testing.T) {
 			data:      "8080:svc",
 			expectErr: true,
 		},
+		{
+			name:      "local-port-out-of-range",
+			data:      "808080:80",
+			expectErr: true,
+		},
+		{
+			name:      "remote-port-out-of-range",
+			data:      "8080:0",
+			expectErr: true,
+		},
+		{
+			name:      "service-port-out-of-range",
+			data:      "8080:svc:70000",
+			expectErr: true,
+		},
+		{
+			name:      "reversed-range",
+			data:      "9010-9000:9010-9000",
+			expectErr: true,
+		},
+		{
+			name:      "different-range-length",
+			data:      "9000-9010:9000-9005",
+			expectErr: true,
+		},
+		{
+			name:      "range-out-of-bounds",
+			data:      "65530-65540:65530-65540",
+			expectErr: true,
+		},
+		{
+			name:      "malformed-range",
+			data:      "9000-9005-9010:9000-9005-9010",
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -144,6 +209,262 @@ func TestForward_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestForward_UnmarshalYAMLExtended(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		expected  Forward
+		expectErr bool
+	}{
+		{
+			name:     "named",
+			data:     "name: api\nlocal: 8080\nremote: 80",
+			expected: Forward{Name: "api", Local: 8080, Remote: 80},
+		},
+		{
+			name:     "unnamed",
+			data:     "local: 8080\nremote: 80",
+			expected: Forward{Local: 8080, Remote: 80},
+		},
+		{
+			name:     "service",
+			data:     "name: db\nlocal: 5432\nremote: 5432\nservice: postgres",
+			expected: Forward{Name: "db", Local: 5432, Remote: 5432, Service: true, ServiceName: "postgres"},
+		},
+		{
+			name:     "unnamed-service",
+			data:     "local: 5432\nremote: 5432\nservice: postgres",
+			expected: Forward{Local: 5432, Remote: 5432, Service: true, ServiceName: "postgres"},
+		},
+		{
+			name:      "unknown-field",
+			data:      "name: api\nlocal: 8080\nremote: 80\naddress: 0.0.0.0",
+			expectErr: true,
+		},
+		{
+			name:     "tcp",
+			data:     "name: api\nlocal: 8080\nremote: 80\nprotocol: tcp",
+			expected: Forward{Name: "api", Local: 8080, Remote: 80},
+		},
+		{
+			name:      "udp",
+			data:      "name: dns\nlocal: 5353\nremote: 53\nprotocol: udp",
+			expectErr: true,
+		},
+		{
+			name:      "missing-remote",
+			data:      "name: api\nlocal: 8080",
+			expectErr: true,
+		},
+		{
+			name:      "bad-local-port",
+			data:      "name: api\nlocal: api\nremote: 80",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Forward
+			if err := yaml.Unmarshal([]byte(tt.data), &result); err != nil {
+				if tt.expectErr {
+					return
+				}
+
+				t.Fatal(err)
+			}
+
+			if tt.expectErr {
+				t.Fatal("didn't got expected error")
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("didn't unmarshal correctly. Actual '%+v', Expected '%+v'", result, tt.expected)
+			}
+
+			out, err := yaml.Marshal(result)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var roundtrip Forward
+			if err := yaml.Unmarshal(out, &roundtrip); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(roundtrip, tt.expected) {
+				t.Errorf("didn't marshal correctly. Actual '%+v', Expected '%+v'", roundtrip, tt.expected)
+			}
+		})
+	}
+}
+
+func TestForward_UnmarshalYAMLExtendedErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "unnamed-missing-local",
+			data:     "remote: 80",
+			expected: "Port-forward '{local: 0, remote: 80}' must define a valid 'local' port",
+		},
+		{
+			name:     "unnamed-missing-remote",
+			data:     "local: 8080",
+			expected: "Port-forward '{local: 8080, remote: 0}' must define a valid 'remote' port",
+		},
+		{
+			name:     "named-missing-remote",
+			data:     "name: api\nlocal: 8080",
+			expected: "Port-forward '{name: api, local: 8080, remote: 0}' must define a valid 'remote' port",
+		},
+		{
+			name:     "unnamed-udp",
+			data:     "local: 5353\nremote: 53\nprotocol: udp",
+			expected: "UDP port-forward '{local: 5353, remote: 53}' is not supported yet, kubernetes port-forwarding only supports TCP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Forward
+			err := yaml.Unmarshal([]byte(tt.data), &result)
+			if err == nil {
+				t.Fatal("didn't got expected error")
+			}
+
+			if err.Error() != tt.expected {
+				t.Errorf("wrong error. Actual '%s', Expected '%s'", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestForward_UnmarshalYAMLProtocol(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		expected  Forward
+		expectErr bool
+	}{
+		{
+			name:     "tcp",
+			data:     "8080:9090/tcp",
+			expected: Forward{Local: 8080, Remote: 9090},
+		},
+		{
+			name:     "service-tcp",
+			data:     "8080:svc:5214/TCP",
+			expected: Forward{Local: 8080, Remote: 5214, Service: true, ServiceName: "svc"},
+		},
+		{
+			name:      "udp",
+			data:      "5353:53/udp",
+			expectErr: true,
+		},
+		{
+			name:      "unknown-protocol",
+			data:      "8080:9090/sctp",
+			expectErr: true,
+		},
+		{
+			name:      "empty-protocol",
+			data:      "8080:9090/",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Forward
+			err := yaml.Unmarshal([]byte(tt.data), &result)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("didn't got expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("didn't unmarshal correctly. Actual '%+v', Expected '%+v'", result, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_expandForwards(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		expected  []Forward
+		expectErr bool
+	}{
+		{
+			name:     "single",
+			data:     `["8080:8080", "5432:db:5432"]`,
+			expected: []Forward{{Local: 8080, Remote: 8080}, {Local: 5432, Remote: 5432, Service: true, ServiceName: "db"}},
+		},
+		{
+			name: "range",
+			data: `["9000-9002:9100-9102"]`,
+			expected: []Forward{
+				{Local: 9000, Remote: 9100},
+				{Local: 9001, Remote: 9101},
+				{Local: 9002, Remote: 9102},
+			},
+		},
+		{
+			name: "service-range",
+			data: `["9000-9001:workers:9000-9001"]`,
+			expected: []Forward{
+				{Local: 9000, Remote: 9000, Service: true, ServiceName: "workers"},
+				{Local: 9001, Remote: 9001, Service: true, ServiceName: "workers"},
+			},
+		},
+		{
+			name:      "overlapping-ranges",
+			data:      `["9000-9005:9000-9005", "9005-9010:9005-9010"]`,
+			expectErr: true,
+		},
+		{
+			name:      "overlapping-port",
+			data:      `["9000-9005:9000-9005", "9003:8080"]`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forwards []Forward
+			if err := yaml.Unmarshal([]byte(tt.data), &forwards); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := expandForwards(forwards)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("didn't got expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("didn't expand correctly. Actual '%+v', Expected '%+v'", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestForward_less(t *testing.T) {
 	tests := []struct {
 		name string
@@ -190,3 +511,12 @@ func TestForward_less(t *testing.T) {
 		})
 	}
 }
+
+func TestForward_IsPrivileged(t *testing.T) {
+	if !(&Forward{Local: 80, Remote: 8080}).IsPrivileged() {
+		t.Error("port 80 should be privileged")
+	}
+	if (&Forward{Local: 8080, Remote: 80}).IsPrivileged() {
+		t.Error("port 8080 shouldn't be privileged")
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	unknownFieldRegex = regexp.MustCompile(`^line (\d+): field (\S+) not found in type \S+$`)
+	keyRegex          = regexp.MustCompile(`^([A-Za-z_][\w.-]*)\s*:`)
+	wrongTypeRegex    = regexp.MustCompile(`^line (\d+): cannot unmarshal (!!\w+)(?: ` + "`" + `(.*)` + "`" + `)? into (\S+)$`)
+
+	yamlTagNames = map[string]string{
+		"!!str":   "a string",
+		"!!int":   "an integer",
+		"!!float": "a number",
+		"!!bool":  "a boolean",
+		"!!map":   "an object",
+		"!!seq":   "a list",
+		"!!null":  "an empty value",
+	}
+)
+
+//formatUnmarshalError rewrites a yaml unmarshal error to name the offending key and the expected type.
+//Errors it doesn't recognize are returned without the go type name
+func formatUnmarshalError(e string, manifest []byte) string {
+	e = strings.TrimSpace(e)
+	if m := unknownFieldRegex.FindStringSubmatch(e); m != nil {
+		return fmt.Sprintf("line %s: unknown field '%s'", m[1], m[2])
+	}
+
+	if m := wrongTypeRegex.FindStringSubmatch(e); m != nil {
+		got := yamlTagNames[m[2]]
+		if got == "" {
+			got = m[2]
+		}
+		if m[3] != "" {
+			got = fmt.Sprintf("%s ('%s')", got, m[3])
+		}
+
+		key := getKeyAtLine(manifest, m[1])
+		if key == "" {
+			return fmt.Sprintf("line %s: expected %s but got %s", m[1], getTypeName(m[4]), got)
+		}
+		return fmt.Sprintf("line %s: '%s' must be %s but got %s", m[1], key, getTypeName(m[4]), got)
+	}
+
+	if i := strings.LastIndex(e, " in type "); i > 0 {
+		return e[:i]
+	}
+	return e
+}
+
+//getKeyAtLine returns the key defined in the given line of the manifest, if any
+func getKeyAtLine(manifest []byte, line string) string {
+	n, err := strconv.Atoi(line)
+	if err != nil {
+		return ""
+	}
+
+	lines := strings.Split(string(manifest), "\n")
+	if n < 1 || n > len(lines) {
+		return ""
+	}
+
+	l := strings.TrimSpace(lines[n-1])
+	l = strings.TrimSpace(strings.TrimPrefix(l, "-"))
+	m := keyRegex.FindStringSubmatch(l)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+//getTypeName returns a user friendly name of a go type
+func getTypeName(t string) string {
+	switch {
+	case strings.HasPrefix(t, "[]"):
+		return "a list"
+	case strings.HasPrefix(t, "map["), strings.HasPrefix(t, "model."):
+		return "an object"
+	case strings.HasPrefix(t, "int"), strings.HasPrefix(t, "uint"):
+		return "an integer"
+	case strings.HasPrefix(t, "float"):
+		return "a number"
+	case t == "bool":
+		return "a boolean"
+	case t == "string", strings.HasSuffix(t, "PullPolicy"), strings.HasSuffix(t, "ServiceType"):
+		return "a string"
+	case t == "time.Duration":
+		return "a duration"
+	}
+	return t
+}
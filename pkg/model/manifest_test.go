@@ -0,0 +1,74 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "testing"
+
+func Test_formatUnmarshalError(t *testing.T) {
+	manifest := []byte(`name: deployment
+remote: abc
+sync:
+  folders:
+    - localPath: .
+  rescanInterval: true
+forward:
+  - 8080:8080
+`)
+
+	var tests = []struct {
+		name     string
+		err      string
+		expected string
+	}{
+		{
+			name:     "unknown-field",
+			err:      "  line 1: field nam not found in type model.Dev",
+			expected: "line 1: unknown field 'nam'",
+		},
+		{
+			name:     "wrong-type",
+			err:      "line 2: cannot unmarshal !!str `abc` into int",
+			expected: "line 2: 'remote' must be an integer but got a string ('abc')",
+		},
+		{
+			name:     "wrong-nested-type",
+			err:      "line 6: cannot unmarshal !!bool `true` into int",
+			expected: "line 6: 'rescanInterval' must be an integer but got a boolean ('true')",
+		},
+		{
+			name:     "wrong-type-without-value",
+			err:      "line 3: cannot unmarshal !!seq into model.SyncInfo",
+			expected: "line 3: 'sync' must be an object but got a list",
+		},
+		{
+			name:     "wrong-type-without-key",
+			err:      "line 8: cannot unmarshal !!map into string",
+			expected: "line 8: expected a string but got an object",
+		},
+		{
+			name:     "unknown-error",
+			err:      "line 4: something else in type model.Dev",
+			expected: "line 4: something else",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatUnmarshalError(tt.err, manifest)
+			if got != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
@@ -13,7 +13,14 @@
 
 package model
 
-import "net"
+import (
+	"fmt"
+	"net"
+
+	"github.com/okteto/okteto/pkg/log"
+)
+
+const maxPortAttempts = 10
 
 // GetAvailablePort returns a random port that's available
 func GetAvailablePort() (int, error) {
@@ -31,3 +38,54 @@ func GetAvailablePort() (int, error) {
 	return listener.Addr().(*net.TCPAddr).Port, nil
 
 }
+
+// GetAvailablePorts returns n different random ports that are available, skipping the reserved ones.
+// The ports are held until all of them are selected, so the same port is never returned twice
+func GetAvailablePorts(n int, reserved []int) ([]int, error) {
+	skip := map[int]bool{}
+	for _, p := range reserved {
+		skip[p] = true
+	}
+
+	listeners := []net.Listener{}
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+
+	ports := []int{}
+	for attempts := 0; len(ports) < n; attempts++ {
+		if attempts >= n+len(reserved)+maxPortAttempts {
+			return nil, fmt.Errorf("couldn't find %d available ports", n)
+		}
+
+		l, err := net.Listen("tcp", ":0")
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, l)
+
+		port := l.Addr().(*net.TCPAddr).Port
+		if skip[port] {
+			continue
+		}
+		ports = append(ports, port)
+	}
+
+	return ports, nil
+}
+
+// IsPortAvailable returns true if the port is available for listening on the given interface
+func IsPortAvailable(iface string, port int) bool {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", iface, port))
+	if err != nil {
+		log.Debugf("port %s:%d is not available: %s", iface, port, err)
+		return false
+	}
+
+	if err := listener.Close(); err != nil {
+		log.Debugf("failed to close listener on %s:%d: %s", iface, port, err)
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"net"
+	"testing"
+)
+
+func TestGetAvailablePorts(t *testing.T) {
+	reserved, err := GetAvailablePort()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ports, err := GetAvailablePorts(4, []int{reserved})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ports) != 4 {
+		t.Fatalf("expected 4 ports, got %v", ports)
+	}
+
+	seen := map[int]bool{reserved: true}
+	for _, p := range ports {
+		if seen[p] {
+			t.Errorf("port %d was returned twice or is reserved: %v", p, ports)
+		}
+		seen[p] = true
+
+		if !IsPortAvailable("localhost", p) {
+			t.Errorf("port %d is not available", p)
+		}
+	}
+}
+
+func TestIsPortAvailable(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	if IsPortAvailable("localhost", port) {
+		t.Errorf("port %d is in use but was reported as available", port)
+	}
+
+	if err := listener.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !IsPortAvailable("localhost", port) {
+		t.Errorf("port %d is free but was reported as in use", port)
+	}
+}
@@ -24,6 +24,7 @@ import (
 )
 
 // UnmarshalYAML Implements the Unmarshaler interface of the yaml pkg.
+// It supports 'KEY=value' and 'KEY'. The latter inherits the value from the local environment.
 func (e *EnvVar) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var raw string
 	err := unmarshal(&raw)
@@ -39,6 +40,7 @@ func (e *EnvVar) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 
 	e.Name = os.ExpandEnv(parts[0])
+	e.Value = os.Getenv(e.Name)
 	return nil
 }
 
@@ -74,7 +76,11 @@ func (s *Secret) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var raw string
 	err := unmarshal(&raw)
 	if err != nil {
-		return err
+		var extended secretExtended
+		if err := unmarshal(&extended); err != nil {
+			return fmt.Errorf("secrets must follow the syntax 'LOCAL_PATH:REMOTE_PATH:MODE' or define 'localPath', 'remotePath' and 'mode'")
+		}
+		return s.fromExtended(extended)
 	}
 
 	rawExpanded := os.ExpandEnv(raw)
@@ -102,6 +108,28 @@ func (s *Secret) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+type secretExtended struct {
+	LocalPath  string `yaml:"localPath"`
+	RemotePath string `yaml:"remotePath"`
+	Mode       *int32 `yaml:"mode,omitempty"`
+}
+
+func (s *Secret) fromExtended(extended secretExtended) error {
+	s.LocalPath = os.ExpandEnv(extended.LocalPath)
+	if err := checkFileAndNotDirectory(s.LocalPath); err != nil {
+		return err
+	}
+	s.RemotePath = os.ExpandEnv(extended.RemotePath)
+	if !strings.HasPrefix(s.RemotePath, "/") {
+		return fmt.Errorf("Secret remote path '%s' must be an absolute path", s.RemotePath)
+	}
+	s.Mode = 420
+	if extended.Mode != nil {
+		s.Mode = *extended.Mode
+	}
+	return nil
+}
+
 // MarshalYAML Implements the marshaler interface of the yaml pkg.
 func (s Secret) MarshalYAML() (interface{}, error) {
 	if s.Mode == 420 {
@@ -157,7 +185,7 @@ func (r *ResourceList) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	for k, v := range raw {
 		parsed, err := resource.ParseQuantity(v)
 		if err != nil {
-			return err
+			return fmt.Errorf("invalid value '%s' for resource '%s': it must be a valid kubernetes quantity, like '500m' or '1Gi'", v, k)
 		}
 
 		(*r)[k] = parsed
@@ -130,6 +130,11 @@ func TestEnvVarMashalling(t *testing.T) {
 			[]byte(`$DEV_ENV`),
 			EnvVar{Name: "test_environment", Value: ""},
 		},
+		{
+			"inherited-key",
+			[]byte(`DEV_ENV`),
+			EnvVar{Name: "DEV_ENV", Value: "test_environment"},
+		},
 		{
 			"just-env-var-undefined",
 			[]byte(`$UNDEFINED`),
@@ -196,6 +201,24 @@ func TestSecretMashalling(t *testing.T) {
 			&Secret{LocalPath: file.Name(), RemotePath: "/remote", Mode: 420},
 			false,
 		},
+		{
+			"extended",
+			fmt.Sprintf("localPath: %s\nremotePath: /remote\nmode: 0400", file.Name()),
+			&Secret{LocalPath: file.Name(), RemotePath: "/remote", Mode: 256},
+			false,
+		},
+		{
+			"extended-default-mode",
+			"localPath: $TEST_HOME\nremotePath: /remote",
+			&Secret{LocalPath: file.Name(), RemotePath: "/remote", Mode: 420},
+			false,
+		},
+		{
+			"extended-wrong-remote",
+			fmt.Sprintf("localPath: %s\nremotePath: remote", file.Name()),
+			nil,
+			true,
+		},
 		{
 			"too-short",
 			"local",
@@ -294,3 +317,48 @@ func TestVolumeMashalling(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceListUnmarshalling(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []byte
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "valid",
+			data:     []byte("cpu: 500m"),
+			expected: "500m",
+		},
+		{
+			name:      "invalid",
+			data:      []byte("cpu: lots"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result ResourceList
+			err := yaml.Unmarshal(tt.data, &result)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("didn't got the expected error")
+				}
+				if !strings.Contains(err.Error(), "lots") {
+					t.Errorf("error doesn't include the invalid value: %s", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cpu := result["cpu"]
+			if cpu.String() != tt.expected {
+				t.Errorf("didn't unmarshal correctly. Actual %s, Expected %s", cpu.String(), tt.expected)
+			}
+		})
+	}
+}
@@ -85,9 +85,7 @@ func ReadStack(bytes []byte) (*Stack, error) {
 			_, _ = sb.WriteString("Invalid stack manifest:\n")
 			l := strings.Split(err.Error(), "\n")
 			for i := 1; i < len(l); i++ {
-				e := strings.TrimSuffix(l[i], "in type model.Stack")
-				e = strings.TrimSpace(e)
-				_, _ = sb.WriteString(fmt.Sprintf("    - %s\n", e))
+				_, _ = sb.WriteString(fmt.Sprintf("    - %s\n", formatUnmarshalError(l[i], bytes)))
 			}
 
 			_, _ = sb.WriteString("    See https://okteto.com/docs/reference/stack for details")
@@ -23,6 +23,8 @@ type Translation struct {
 	Interactive bool               `json:"interactive"`
 	Name        string             `json:"name"`
 	Version     string             `json:"version"`
+	GitCommit   string             `json:"-"`
+	Session     string             `json:"-"`
 	Deployment  *appsv1.Deployment `json:"-"`
 	Annotations map[string]string  `json:"-"`
 	Replicas    int32              `json:"replicas"`
@@ -37,11 +39,14 @@ type TranslationRule struct {
 	Image            string               `json:"image,omitempty"`
 	ImagePullPolicy  apiv1.PullPolicy     `json:"imagePullPolicy,omitempty" yaml:"imagePullPolicy,omitempty"`
 	Environment      []EnvVar             `json:"environment,omitempty"`
+	EnvFrom          []EnvFrom            `json:"envFrom,omitempty"`
 	Secrets          []Secret             `json:"secrets,omitempty"`
 	Command          []string             `json:"command,omitempty"`
 	Args             []string             `json:"args,omitempty"`
 	WorkDir          string               `json:"workdir"`
 	Healthchecks     bool                 `json:"healthchecks" yaml:"healthchecks"`
+	DockerSocket     bool                 `json:"dockerSocket,omitempty" yaml:"dockerSocket,omitempty"`
+	ImagePullSecrets []string             `json:"imagePullSecrets,omitempty" yaml:"imagePullSecrets,omitempty"`
 	PersistentVolume bool                 `json:"persistentVolume" yaml:"persistentVolume"`
 	Volumes          []VolumeMount        `json:"volumes,omitempty"`
 	SecurityContext  *SecurityContext     `json:"securityContext,omitempty"`
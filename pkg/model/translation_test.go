@@ -193,3 +193,42 @@ func TestSSHServerPortTranslationRule(t *testing.T) {
 		}
 	}
 }
+
+func TestHistoryTranslationRule(t *testing.T) {
+	manifest := []byte(`name: web
+image: web:latest
+persistentVolume:
+  enabled: true
+history:
+  enabled: true`)
+
+	dev, err := Read(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	rule := dev.ToTranslationRule(dev)
+
+	expectedVolume := VolumeMount{
+		Name:      dev.GetVolumeName(),
+		MountPath: OktetoHistoryMountPath,
+		SubPath:   HistorySubPath,
+	}
+	found := false
+	for _, v := range rule.Volumes {
+		if reflect.DeepEqual(v, expectedVolume) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("history volume not found in %+v", rule.Volumes)
+	}
+
+	expectedEnv := EnvVar{Name: "HISTFILE", Value: path.Join(OktetoHistoryMountPath, historyFileName)}
+	if e := rule.Environment[len(rule.Environment)-1]; !reflect.DeepEqual(e, expectedEnv) {
+		t.Errorf("expected %+v, got %+v", expectedEnv, e)
+	}
+}
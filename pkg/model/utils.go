@@ -31,7 +31,7 @@ func FileExists(name string) bool {
 	}
 
 	if err != nil {
-		log.Infof("Failed to check if %s exists: %s", name, err)
+		log.Debugf("Failed to check if %s exists: %s", name, err)
 	}
 
 	return true
@@ -69,6 +69,6 @@ func GetValidNameFromFolder(folder string) (string, error) {
 	name := filepath.Base(dir)
 	name = strings.ToLower(name)
 	name = ValidKubeNameRegex.ReplaceAllString(name, "-")
-	log.Infof("autogenerated name: %s", name)
+	log.Debugf("autogenerated name: %s", name)
 	return name, nil
 }
@@ -75,12 +75,12 @@ func AuthWithToken(ctx context.Context, url, token string) (*User, error) {
 
 	user, err := queryUser(ctx, client, token)
 	if err != nil {
-		log.Infof("failed to login the user: %s", err)
+		log.Debugf("failed to login the user: %s", err)
 		return nil, fmt.Errorf("invalid API token")
 	}
 
 	if err := saveAuthData(&user.User, url); err != nil {
-		log.Infof("failed to save the login data: %s", err)
+		log.Debugf("failed to save the login data: %s", err)
 		return nil, fmt.Errorf("failed to save the login data locally")
 	}
 
@@ -136,7 +136,7 @@ func queryUser(ctx context.Context, client *graphql.Client, token string) (*q, e
 	if err := client.Run(ctx, req, &user); err != nil {
 		if err := client.Run(ctx, req, &user); err != nil {
 			if strings.Contains(err.Error(), "Cannot query field") {
-				log.Infof("query using the legacy parameters: %s", err)
+				log.Debugf("query using the legacy parameters: %s", err)
 				return queryUserLegacy(ctx, client, token)
 			}
 			return nil, fmt.Errorf("unauthorized request: %w", err)
@@ -174,7 +174,7 @@ func authUser(ctx context.Context, client *graphql.Client, code string) (*u, err
 	req := graphql.NewRequest(q)
 	if err := client.Run(ctx, req, &user); err != nil {
 		if strings.Contains(err.Error(), "Cannot query field") {
-			log.Infof("query using the legacy parameters: %s", err)
+			log.Debugf("query using the legacy parameters: %s", err)
 			return authUserLegacy(ctx, client, code)
 		}
 		return nil, fmt.Errorf("unauthorized request: %w", err)
@@ -301,7 +301,7 @@ func saveToken(id, token, url, registry, buildkit string) error {
 func SaveMachineID(machineID string) error {
 	t, err := GetToken()
 	if err != nil {
-		log.Infof("bad token, re-initializing: %s", err)
+		log.Debugf("bad token, re-initializing: %s", err)
 		t = &Token{}
 	}
 
@@ -324,7 +324,7 @@ func SaveID(userID string) error {
 func save(t *Token) error {
 	marshalled, err := json.Marshal(t)
 	if err != nil {
-		log.Infof("failed to marshal token: %s", err)
+		log.Debugf("failed to marshal token: %s", err)
 		return fmt.Errorf("Failed to generate your auth token")
 	}
 
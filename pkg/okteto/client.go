@@ -53,13 +53,13 @@ func getRequest(q, token string) *graphql.Request {
 func query(ctx context.Context, query string, result interface{}) error {
 	t, err := GetToken()
 	if err != nil {
-		log.Infof("couldn't get token: %s", err)
+		log.Debugf("couldn't get token: %s", err)
 		return errors.ErrNotLogged
 	}
 
 	c, err := getClient(t.URL)
 	if err != nil {
-		log.Infof("error getting the graphql client: %s", err)
+		log.Debugf("error getting the graphql client: %s", err)
 		return fmt.Errorf("internal server error")
 	}
 
@@ -81,22 +81,32 @@ func query(ctx context.Context, query string, result interface{}) error {
 }
 
 func isNotAuthorized(s string) bool {
-	return strings.Contains(s, "not-authorized")
+	return strings.Contains(s, "not-authorized") || strings.Contains(strings.ToLower(s), "unauthenticated")
 }
 
 func isConnectionError(s string) bool {
 	return strings.Contains(s, "decoding response") || strings.Contains(s, "reading body")
 }
 
+//GetContextName returns the default kubeconfig context name for a given namespace and cluster
+func GetContextName(namespace, clusterName string) string {
+	if namespace == "" {
+		// don't include namespace for the personal namespace
+		return clusterName
+	}
+	return fmt.Sprintf("%s-%s", clusterName, namespace)
+}
+
 //SetKubeConfig updates a kubeconfig file with okteto cluster credentials
 func SetKubeConfig(cred *Credential, kubeConfigPath, namespace, userName, clusterName string) error {
-	contextName := ""
+	return SetKubeConfigContext(cred, kubeConfigPath, namespace, userName, clusterName, GetContextName(namespace, clusterName))
+}
+
+//SetKubeConfigContext updates a kubeconfig file with okteto cluster credentials under the given context name.
+//Existing clusters, users and contexts are updated in place.
+func SetKubeConfigContext(cred *Credential, kubeConfigPath, namespace, userName, clusterName, contextName string) error {
 	if namespace == "" {
-		// don't include namespace for the personal namespace
-		contextName = clusterName
 		namespace = cred.Namespace
-	} else {
-		contextName = fmt.Sprintf("%s-%s", clusterName, namespace)
 	}
 
 	var cfg *clientcmdapi.Config
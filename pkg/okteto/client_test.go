@@ -90,3 +90,57 @@ func TestSetKubeConfig(t *testing.T) {
 	}
 
 }
+
+func TestSetKubeConfigContext(t *testing.T) {
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	defer os.Remove(file.Name())
+	c := &Credential{Namespace: "cindy"}
+	if err := SetKubeConfigContext(c, file.Name(), "", "123-123-123", "cloud-okteto-com", "my-context"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := SetKubeConfigContext(c, file.Name(), "ns", "123-123-123", "cloud-okteto-com", "my-context"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	cfg, err := clientcmd.LoadFromFile(file.Name())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(cfg.Contexts) != 1 {
+		t.Errorf("the config file didn't have one context: %+v", cfg.Contexts)
+	}
+
+	if cfg.CurrentContext != "my-context" {
+		t.Errorf("current context was not my-context, it was %s", cfg.CurrentContext)
+	}
+
+	if cfg.Contexts["my-context"].Namespace != "ns" {
+		t.Errorf("context namespace was not updated in place, it was %s", cfg.Contexts["my-context"].Namespace)
+	}
+}
+
+func Test_isNotAuthorized(t *testing.T) {
+	var tests = []struct {
+		name     string
+		err      string
+		expected bool
+	}{
+		{name: "not-authorized", err: "not-authorized", expected: true},
+		{name: "unauthenticated", err: "UNAUTHENTICATED: token expired", expected: true},
+		{name: "internal", err: "internal server error", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNotAuthorized(tt.err); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
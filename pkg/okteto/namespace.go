@@ -28,9 +28,15 @@ type DeleteBody struct {
 	Namespace Namespace `json:"deleteSpace" yaml:"deleteSpace"`
 }
 
+// SpacesBody top body answer
+type SpacesBody struct {
+	Spaces []Namespace `json:"spaces" yaml:"spaces"`
+}
+
 //Namespace represents an Okteto k8s namespace
 type Namespace struct {
-	ID string `json:"id" yaml:"id"`
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
 }
 
 // CreateNamespace creates a namespace
@@ -64,3 +70,19 @@ func DeleteNamespace(ctx context.Context, namespace string) error {
 
 	return nil
 }
+
+// ListNamespaces returns the namespaces the user has access to
+func ListNamespaces(ctx context.Context) ([]Namespace, error) {
+	q := `query{
+		spaces{
+			id, name
+		},
+	}`
+
+	var body SpacesBody
+	if err := query(ctx, q, &body); err != nil {
+		return nil, err
+	}
+
+	return body.Spaces, nil
+}
@@ -223,7 +223,7 @@ func (config *sshConfig) writeTo(w io.Writer) error {
 func (config *sshConfig) writeToFilepath(p string) error {
 	sshDir := filepath.Dir(p)
 	if err := os.MkdirAll(sshDir, 0700); err != nil {
-		log.Infof("failed to create SSH directory %s: %s", sshDir, err)
+		log.Debugf("failed to create SSH directory %s: %s", sshDir, err)
 	}
 
 	stat, err := os.Stat(p)
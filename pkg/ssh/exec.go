@@ -29,7 +29,7 @@ import (
 
 // Exec executes the command over SSH
 func Exec(ctx context.Context, remotePort int, tty bool, inR io.Reader, outW, errW io.Writer, command []string) error {
-	log.Info("starting SSH connection")
+	log.Debug("starting SSH connection")
 	sshConfig, err := getSSHClientConfig()
 	if err != nil {
 		return fmt.Errorf("failed to get SSH configuration: %s", err)
@@ -61,23 +61,24 @@ func Exec(ctx context.Context, remotePort int, tty bool, inR io.Reader, outW, er
 	defer session.Close()
 
 	if tty {
+		fd := int(os.Stdin.Fd())
 		modes := ssh.TerminalModes{
 			ssh.ECHO:  0, // Disable echoing
 			ssh.IGNCR: 1, // Ignore CR on input
 		}
 
-		state, err := terminal.MakeRaw(0)
+		state, err := terminal.MakeRaw(fd)
 		if err != nil {
 			return fmt.Errorf("request for raw terminal failed: %s", err)
 		}
 
 		defer func() {
-			if err := terminal.Restore(0, state); err != nil {
-				log.Infof("failed to restore terminal: %s", err)
+			if err := terminal.Restore(fd, state); err != nil {
+				log.Debugf("failed to restore terminal: %s", err)
 			}
 		}()
 
-		width, height, err := terminal.GetSize(0)
+		width, height, err := terminal.GetSize(fd)
 		if err != nil {
 			return fmt.Errorf("request for terminal size failed: %s", err)
 		}
@@ -85,17 +86,21 @@ func Exec(ctx context.Context, remotePort int, tty bool, inR io.Reader, outW, er
 		if err := session.RequestPty("xterm", height, width, modes); err != nil {
 			return fmt.Errorf("request for pseudo terminal failed: %s", err)
 		}
+
+		done := make(chan struct{})
+		defer close(done)
+		monitorWindowChanges(done, session, fd)
 	}
 
 	sockEnvVar, ok := os.LookupEnv("SSH_AUTH_SOCK")
 	if !ok {
-		log.Info("SSH_AUTH_SOCK is not set, not forwarding socket")
+		log.Debug("SSH_AUTH_SOCK is not set, not forwarding socket")
 	} else {
 		if err := agent.ForwardToRemote(connection, sockEnvVar); err != nil {
-			log.Infof("failed to existing SSH_AUTH_SOCK('%s'): %s", sockEnvVar, err)
+			log.Debugf("failed to existing SSH_AUTH_SOCK('%s'): %s", sockEnvVar, err)
 		}
 		if err := agent.RequestAgentForwarding(session); err != nil {
-			log.Infof("failed to forward ssh agent to remote: %s", err)
+			log.Debugf("failed to forward ssh agent to remote: %s", err)
 		}
 	}
 
@@ -105,7 +110,7 @@ func Exec(ctx context.Context, remotePort int, tty bool, inR io.Reader, outW, er
 	}
 	go func() {
 		if _, err = io.Copy(stdin, inR); err != nil {
-			log.Infof("error while reading from stdIn: %s", err)
+			log.Debugf("error while reading from stdIn: %s", err)
 		}
 	}()
 
@@ -116,7 +121,7 @@ func Exec(ctx context.Context, remotePort int, tty bool, inR io.Reader, outW, er
 
 	go func() {
 		if _, err := io.Copy(outW, stdout); err != nil {
-			log.Infof("error while writing to stdOut: %s", err)
+			log.Debugf("error while writing to stdOut: %s", err)
 		}
 	}()
 
@@ -127,11 +132,11 @@ func Exec(ctx context.Context, remotePort int, tty bool, inR io.Reader, outW, er
 
 	go func() {
 		if _, err := io.Copy(errW, stderr); err != nil {
-			log.Infof("error while writing to stdOut: %s", err)
+			log.Debugf("error while writing to stdOut: %s", err)
 		}
 	}()
 
 	cmd := strings.Join(command, " ")
-	log.Infof("executing command over SSH: '%s'", cmd)
+	log.Debugf("executing command over SSH: '%s'", cmd)
 	return session.Run(cmd)
 }
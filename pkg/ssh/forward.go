@@ -48,33 +48,43 @@ func (f *forward) setConnected() {
 func (f *forward) start() {
 	localListener, err := net.Listen("tcp", f.localAddress)
 	if err != nil {
-		log.Infof("%s -> failed to listen on local address: %v", f.String(), err)
+		log.Debugf("%s -> failed to listen on local address: %v", f.String(), err)
 		return
 	}
 
 	defer localListener.Close()
+	go closeOnDone(f.ctx, localListener)
 
 	f.setConnected()
 
 	for {
-		log.Infof("%s -> waiting for a connection", f.String())
+		log.Debugf("%s -> waiting for a connection", f.String())
 		localConn, err := localListener.Accept()
 		if err != nil {
-			log.Infof("%s -> failed to accept connection: %v", f.String(), err)
+			if f.ctx.Err() != nil {
+				log.Debugf("%s -> stopped listening", f.String())
+				return
+			}
+			log.Debugf("%s -> failed to accept connection: %v", f.String(), err)
 			continue
 		}
 
-		log.Infof("%s -> accepted connection: %v", f.String(), localConn)
+		log.Debugf("%s -> accepted connection: %v", f.String(), localConn)
 		go f.handle(localConn)
 	}
 }
 
+func closeOnDone(ctx context.Context, l net.Listener) {
+	<-ctx.Done()
+	l.Close()
+}
+
 func (f *forward) handle(local net.Conn) {
 	defer local.Close()
 
 	remote, err := f.pool.get(f.remoteAddress)
 	if err != nil {
-		log.Infof("%s -> forwarding failed: %s", f.String(), err)
+		log.Debugf("%s -> forwarding failed: %s", f.String(), err)
 		return
 	}
 
@@ -86,7 +96,7 @@ func (f *forward) handle(local net.Conn) {
 	go f.transfer(local, remote, quit)
 
 	<-quit
-	log.Infof("%s -> stopped", f.String())
+	log.Debugf("%s -> stopped", f.String())
 }
 
 func (f *forward) String() string {
@@ -96,7 +106,7 @@ func (f *forward) String() string {
 func (f *forward) transfer(from io.Writer, to io.Reader, quit chan struct{}) {
 	_, err := io.Copy(from, to)
 	if err != nil {
-		log.Infof("%s -> data transfer failed: %v", f.String(), err)
+		log.Debugf("%s -> data transfer failed: %v", f.String(), err)
 	}
 
 	quit <- struct{}{}
@@ -38,18 +38,18 @@ const (
 func KeyExists() bool {
 	public, private := getKeyPaths()
 	if !model.FileExists(public) {
-		log.Infof("%s doesn't exist", public)
+		log.Debugf("%s doesn't exist", public)
 		return false
 	}
 
-	log.Infof("%s already present", public)
+	log.Debugf("%s already present", public)
 
 	if !model.FileExists(private) {
-		log.Infof("%s doesn't exist", private)
+		log.Debugf("%s doesn't exist", private)
 		return false
 	}
 
-	log.Infof("%s already present", private)
+	log.Debugf("%s already present", private)
 	return true
 }
 
@@ -80,7 +80,7 @@ func generateKeys(public, private string, bitSize int) error {
 		return fmt.Errorf("failed to write private SSH key: %s", err)
 	}
 
-	log.Infof("created ssh keypair at  %s and %s", public, private)
+	log.Debugf("created ssh keypair at  %s and %s", public, private)
 	return nil
 }
 
@@ -34,6 +34,7 @@ type ForwardManager struct {
 	ctx             context.Context
 	sshAddr         string
 	pf              *k8sforward.PortForwardManager
+	pool            *pool
 }
 
 // NewForwardManager returns a newly initialized instance of ForwardManager
@@ -65,8 +66,7 @@ func (fm *ForwardManager) canAdd(localPort int) error {
 func (fm *ForwardManager) Add(f model.Forward) error {
 
 	if err := fm.canAdd(f.Local); err != nil {
-		return nil
-
+		return err
 	}
 
 	fm.forwards[f.Local] = &forward{
@@ -79,13 +79,13 @@ func (fm *ForwardManager) Add(f model.Forward) error {
 
 // Start starts a port-forward to the remote port and then starts forwards and reverse forwards as goroutines
 func (fm *ForwardManager) Start(devPod, namespace string) error {
-	log.Info("starting SSH forward manager")
+	log.Debug("starting SSH forward manager")
 	if fm.pf != nil {
 		if err := fm.pf.Start(devPod, namespace); err != nil {
 			return fmt.Errorf("failed to start SSH port-forward: %w", err)
 		}
 
-		log.Info("port forward to dev pod connected")
+		log.Debug("port forward to dev pod connected")
 	}
 
 	c, err := getSSHClientConfig()
@@ -93,11 +93,12 @@ func (fm *ForwardManager) Start(devPod, namespace string) error {
 		return fmt.Errorf("failed to get SSH configuration: %s", err)
 	}
 
-	log.Infof("starting SSH connection pool on %s", fm.sshAddr)
+	log.Debugf("starting SSH connection pool on %s", fm.sshAddr)
 	pool, err := startPool(fm.ctx, fm.sshAddr, c)
 	if err != nil {
 		return err
 	}
+	fm.pool = pool
 
 	for _, ff := range fm.forwards {
 		ff.pool = pool
@@ -114,7 +115,9 @@ func (fm *ForwardManager) Start(devPod, namespace string) error {
 
 // Stop sends a stop signal to all the connections
 func (fm *ForwardManager) Stop() {
-	// TODO stop forwards and reverses
+	if fm.pool != nil {
+		fm.pool.stop()
+	}
 
 	if fm.pf != nil {
 		fm.pf.Stop()
@@ -63,16 +63,23 @@ func (p *pool) keepAlive() {
 	for {
 		select {
 		case <-p.ctx.Done():
-			log.Infof("ssh pool keep alive completed")
+			log.Debugf("ssh pool keep alive completed")
+			if err := p.client.Close(); err != nil {
+				log.Debugf("failed to close SSH pool: %s", err)
+			}
 			return
 		case <-t.C:
 			if _, _, err := p.client.SendRequest("dev.okteto.com/keepalive", true, nil); err != nil {
-				log.Infof("failed to send SSH keepalive: %s", err)
+				log.Debugf("failed to send SSH keepalive: %s", err)
 			}
 		}
 	}
 }
 
+func (p *pool) stop() {
+	p.cancel()
+}
+
 func (p *pool) get(address string) (net.Conn, error) {
 	c, err := p.client.Dial("tcp", address)
 	if err != nil {
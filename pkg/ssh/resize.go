@@ -0,0 +1,51 @@
+// +build !windows
+
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/okteto/okteto/pkg/log"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+//monitorWindowChanges forwards the local terminal size to the remote pty every time a SIGWINCH is received, until done is closed
+func monitorWindowChanges(done <-chan struct{}, session *ssh.Session, fd int) {
+	sigwinch := make(chan os.Signal, 1)
+	signal.Notify(sigwinch, syscall.SIGWINCH)
+	go func() {
+		defer signal.Stop(sigwinch)
+		for {
+			select {
+			case <-done:
+				return
+			case <-sigwinch:
+				width, height, err := terminal.GetSize(fd)
+				if err != nil {
+					log.Debugf("failed to get terminal size: %s", err)
+					continue
+				}
+
+				if err := session.WindowChange(height, width); err != nil {
+					log.Debugf("failed to send window change request: %s", err)
+				}
+			}
+		}
+	}()
+}
@@ -47,24 +47,29 @@ func (fm *ForwardManager) AddReverse(f model.Reverse) error {
 func (r *reverse) start() {
 	remoteListener, err := r.pool.getListener(r.remoteAddress)
 	if err != nil {
-		log.Infof("%s -> failed to listen on remote address: %v", r.String(), err)
+		log.Debugf("%s -> failed to listen on remote address: %v", r.String(), err)
 		return
 	}
 
 	defer remoteListener.Close()
+	go closeOnDone(r.ctx, remoteListener)
 
 	for {
 
 		r.setConnected()
 
-		log.Infof("%s -> waiting for a connection", r.String())
+		log.Debugf("%s -> waiting for a connection", r.String())
 		remoteConn, err := remoteListener.Accept()
 		if err != nil {
-			log.Infof("%s -> failed to accept connection: %v", r.String(), err)
+			if r.ctx.Err() != nil {
+				log.Debugf("%s -> stopped listening", r.String())
+				return
+			}
+			log.Debugf("%s -> failed to accept connection: %v", r.String(), err)
 			continue
 		}
 
-		log.Infof("%s -> accepted connection: %v", r.String(), remoteConn)
+		log.Debugf("%s -> accepted connection: %v", r.String(), remoteConn)
 		go r.handle(remoteConn)
 
 	}
@@ -76,7 +81,7 @@ func (r *reverse) handle(remote net.Conn) {
 	quit := make(chan struct{}, 1)
 	local, err := getConn(r.localAddress, 3)
 	if err != nil {
-		log.Infof("%s -> failed to listen on local address: %v", r.String(), err)
+		log.Debugf("%s -> failed to listen on local address: %v", r.String(), err)
 		return
 	}
 
@@ -86,7 +91,7 @@ func (r *reverse) handle(remote net.Conn) {
 	go r.transfer(local, remote, quit)
 
 	<-quit
-	log.Infof("%s -> stopped", r.String())
+	log.Debugf("%s -> stopped", r.String())
 }
 
 func (r *reverse) String() string {
@@ -96,7 +101,7 @@ func (r *reverse) String() string {
 func (r *reverse) transfer(from io.Writer, to io.Reader, quit chan struct{}) {
 	_, err := io.Copy(from, to)
 	if err != nil {
-		log.Infof("%s -> data transfer failed: %v", r.String(), err)
+		log.Debugf("%s -> data transfer failed: %v", r.String(), err)
 	}
 
 	quit <- struct{}{}
@@ -14,7 +14,7 @@
 package syncthing
 
 const configXML = `<configuration version="29">
-<folder id="okteto-{{ .Dev.Name }}" label="{{ .Dev.Name }}" path="{{ .Source }}" type="{{ .Type }}" rescanIntervalS="300" fsWatcherEnabled="true" fsWatcherDelayS="1" ignorePerms="false" autoNormalize="true">
+<folder id="okteto-{{ .Dev.Name }}" label="{{ .Dev.Name }}" path="{{ .Source }}" type="{{ .Type }}" rescanIntervalS="{{ .Dev.Sync.RescanInterval }}" fsWatcherEnabled="true" fsWatcherDelayS="1" ignorePerms="{{ $.Dev.Sync.IgnorePermissions }}" autoNormalize="true">
     <filesystemType>basic</filesystemType>
     <device id="ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR" introducedBy=""></device>
     <device id="{{$.RemoteDeviceID}}" introducedBy=""></device>
@@ -35,6 +35,29 @@ const configXML = `<configuration version="29">
     <markerName>{{ .DevPath }}</markerName>
     <useLargeBlocks>false</useLargeBlocks>
 </folder>
+{{ range .Folders }}
+<folder id="{{ .ID }}" label="{{ .ID }}" path="{{ .LocalPath }}" type="{{ if .ReadOnly }}sendonly{{ else }}{{ $.Type }}{{ end }}" rescanIntervalS="{{ $.Dev.Sync.RescanInterval }}" fsWatcherEnabled="true" fsWatcherDelayS="1" ignorePerms="{{ $.Dev.Sync.IgnorePermissions }}" autoNormalize="true">
+    <filesystemType>basic</filesystemType>
+    <device id="ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR" introducedBy=""></device>
+    <device id="{{$.RemoteDeviceID}}" introducedBy=""></device>
+    <minDiskFree unit="%">1</minDiskFree>
+    <versioning></versioning>
+    <copiers>0</copiers>
+    <pullerMaxPendingKiB>0</pullerMaxPendingKiB>
+    <hashers>0</hashers>
+    <order>random</order>
+    <ignoreDelete>{{ if .ReadOnly }}false{{ else }}{{ $.IgnoreDelete }}{{ end }}</ignoreDelete>
+    <scanProgressIntervalS>2</scanProgressIntervalS>
+    <pullerPauseS>0</pullerPauseS>
+    <maxConflicts>0</maxConflicts>
+    <disableSparseFiles>false</disableSparseFiles>
+    <disableTempIndexes>false</disableTempIndexes>
+    <paused>false</paused>
+    <weakHashThresholdPct>25</weakHashThresholdPct>
+    <markerName>.stfolder</markerName>
+    <useLargeBlocks>false</useLargeBlocks>
+</folder>
+{{ end }}
 <device id="ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR" name="local" compression="local" introducer="false" skipIntroductionRemovals="false" introducedBy="">
     <address>dynamic</address>
     <paused>false</paused>
@@ -68,7 +91,7 @@ const configXML = `<configuration version="29">
     <relaysEnabled>false</relaysEnabled>
     <relayReconnectIntervalM>10</relayReconnectIntervalM>
     <startBrowser>false</startBrowser>
-    <natEnabled>true</natEnabled>
+    <natEnabled>false</natEnabled>
     <natLeaseMinutes>60</natLeaseMinutes>
     <natRenewalMinutes>30</natRenewalMinutes>
     <natTimeoutSeconds>10</natTimeoutSeconds>
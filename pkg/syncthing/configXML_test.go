@@ -0,0 +1,81 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncthing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/model"
+)
+
+func Test_configTemplateIgnorePerms(t *testing.T) {
+	var tests = []struct {
+		name              string
+		ignorePermissions bool
+		expected          string
+	}{
+		{name: "default", ignorePermissions: false, expected: `ignorePerms="false"`},
+		{name: "ignore-permissions", ignorePermissions: true, expected: `ignorePerms="true"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Syncthing{
+				Dev: &model.Dev{
+					Name: "dev",
+					Sync: model.SyncInfo{
+						RescanInterval:    model.DefaultSyncRescanInterval,
+						IgnorePermissions: tt.ignorePermissions,
+					},
+				},
+			}
+			buf := new(bytes.Buffer)
+			if err := configTemplate.Execute(buf, s); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(buf.String(), tt.expected) {
+				t.Errorf("config doesn't contain '%s'", tt.expected)
+			}
+		})
+	}
+}
+
+func Test_configTemplateFolders(t *testing.T) {
+	s := &Syncthing{
+		Type: "sendreceive",
+		Dev: &model.Dev{
+			Name:      "dev",
+			DevDir:    "/home/okteto/dev",
+			MountPath: "/okteto",
+			Sync: model.SyncInfo{
+				RescanInterval: model.DefaultSyncRescanInterval,
+				Folders: []model.SyncFolder{
+					{LocalPath: "vendor", RemotePath: "/okteto/vendor", ReadOnly: true},
+					{LocalPath: "../lib", RemotePath: "/usr/src/lib"},
+				},
+			},
+		},
+	}
+	buf := new(bytes.Buffer)
+	if err := configTemplate.Execute(buf, s); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{`id="okteto-dev-0"`, `type="sendonly"`, `id="okteto-dev-1"`, `type="sendreceive"`} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("config doesn't contain '%s'", expected)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncthing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+)
+
+const (
+	stignoreFile      = ".stignore"
+	oktetoIgnoreFile  = ".oktetoignore"
+	oktetoIgnoreBegin = "// begin of .oktetoignore patterns (managed by okteto)"
+	oktetoIgnoreEnd   = "// end of .oktetoignore patterns"
+)
+
+// updateLocalIgnores merges the patterns of the '.oktetoignore' file into the ignores of the local syncthing.
+// The '.oktetoignore' patterns are placed before the '.stignore' patterns, so they take precedence when both files exist.
+// Read-only folders are also ignored, as they are synchronized by their own syncthing folder,
+// and so are the symlinks that point outside of the synchronized folder.
+// Syncthing only reads the ignores of a folder from its '.stignore' file, so the patterns are written to the '.stignore' file
+// of the local folder, which is created if it doesn't exist, in a block delimited by the okteto markers.
+// The '.stignore' file is only modified when there are patterns to add, or a previous block to remove.
+func (s *Syncthing) updateLocalIgnores(ctx context.Context, dev *model.Dev) error {
+	oktetoIgnores, err := readOktetoIgnore(dev)
+	if err != nil {
+		return err
+	}
+	oktetoIgnores = append(getSyncFoldersIgnores(dev), oktetoIgnores...)
+
+	params := getFolderParameter(dev)
+	ignores := &Ignores{}
+	body, err := s.APICall(ctx, "rest/db/ignores", "GET", 200, params, true, nil)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, ignores); err != nil {
+		return err
+	}
+
+	// the okteto patterns take precedence, as in the merged ignores
+	matcher := newIgnoreMatcher(append(append([]string{}, oktetoIgnores...), mergeIgnores(ignores.Ignore, nil)...))
+	oktetoIgnores = append(getExternalSymlinksIgnores(dev.DevDir, matcher), oktetoIgnores...)
+
+	merged := mergeIgnores(ignores.Ignore, oktetoIgnores)
+	// syncthing returns null ignores when the '.stignore' file doesn't exist
+	if (len(merged) == 0 && len(ignores.Ignore) == 0) || reflect.DeepEqual(merged, ignores.Ignore) {
+		return nil
+	}
+
+	body, err = json.Marshal(&Ignores{Ignore: merged})
+	if err != nil {
+		return err
+	}
+
+	stignore := filepath.Join(dev.DevDir, stignoreFile)
+	if _, err := os.Stat(stignore); os.IsNotExist(err) {
+		log.Information("Creating '%s' to exclude the files managed by okteto from the file synchronization", stignore)
+	} else if !hasManagedIgnores(ignores.Ignore) {
+		log.Information("Adding the patterns managed by okteto to '%s'", stignore)
+	}
+
+	log.Debugf("updating local ignores with the '%s' patterns", oktetoIgnoreFile)
+	_, err = s.APICall(ctx, "rest/db/ignores", "POST", 200, params, true, body)
+	return err
+}
+
+func readOktetoIgnore(dev *model.Dev) ([]string, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dev.DevDir, oktetoIgnoreFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return translateOktetoIgnore(strings.Split(string(b), "\n")), nil
+}
+
+//getSyncFoldersIgnores returns the patterns to exclude the sync folders from the main folder, both by their local and remote paths
+func getSyncFoldersIgnores(dev *model.Dev) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	add := func(p string) {
+		p = "/" + path.Clean(p)
+		if !seen[p] {
+			seen[p] = true
+			result = append(result, p)
+		}
+	}
+	mountPath := path.Clean(dev.MountPath) + "/"
+	for _, f := range dev.Sync.Folders {
+		localPath := path.Clean(filepath.ToSlash(f.LocalPath))
+		if !filepath.IsAbs(f.LocalPath) && localPath != ".." && !strings.HasPrefix(localPath, "../") {
+			add(localPath)
+		}
+		if remotePath := path.Clean(f.RemotePath); strings.HasPrefix(remotePath, mountPath) {
+			add(strings.TrimPrefix(remotePath, mountPath))
+		}
+	}
+	return result
+}
+
+//getExternalSymlinksIgnores returns the patterns to exclude the symlinks that point outside of root.
+//Syncthing synchronizes symlinks as links, so their targets wouldn't exist in the development container.
+//Paths already ignored by matcher are skipped
+func getExternalSymlinksIgnores(root string, matcher *ignoreMatcher) []string {
+	result := []string{}
+	if root == "" {
+		return result
+	}
+
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		realRoot = root
+	}
+
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			log.Debugf("error reading '%s': %s", p, err)
+			return nil
+		}
+
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil || rel == "." {
+			return nil
+		}
+
+		if matcher.ignored(filepath.ToSlash(rel)) {
+			if info.IsDir() && !matcher.hasNegations {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.Mode()&os.ModeSymlink == 0 {
+			return nil
+		}
+
+		target, err := os.Readlink(p)
+		if err != nil {
+			log.Debugf("error reading symlink '%s': %s", p, err)
+			return nil
+		}
+
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(filepath.Dir(p), target)
+		}
+
+		if isSubPath(root, target) || isSubPath(realRoot, target) {
+			return nil
+		}
+
+		log.Debugf("symlink '%s' points outside of the development folder", rel)
+		result = append(result, "/"+filepath.ToSlash(rel))
+		return nil
+	})
+
+	if err != nil {
+		log.Debugf("error looking for symlinks in '%s': %s", root, err)
+	}
+
+	switch len(result) {
+	case 0:
+	case 1:
+		log.Yellow("Symlink '%s' points outside of your development folder and won't be synchronized", strings.TrimPrefix(result[0], "/"))
+	default:
+		log.Yellow("%d symlinks point outside of your development folder and won't be synchronized", len(result))
+	}
+
+	return result
+}
+
+//ignoreMatcher matches paths against syncthing ignore patterns. The first matching pattern applies
+type ignoreMatcher struct {
+	patterns     []ignorePattern
+	hasNegations bool
+}
+
+type ignorePattern struct {
+	re     *regexp.Regexp
+	negate bool
+}
+
+func newIgnoreMatcher(lines []string) *ignoreMatcher {
+	m := &ignoreMatcher{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		p := ignorePattern{}
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		}
+
+		caseInsensitive := false
+		for {
+			if strings.HasPrefix(line, "(?d)") {
+				line = line[4:]
+			} else if strings.HasPrefix(line, "(?i)") {
+				caseInsensitive = true
+				line = line[4:]
+			} else {
+				break
+			}
+		}
+
+		expr := "^(.*/)?"
+		if strings.HasPrefix(line, "/") {
+			expr = "^"
+		}
+		expr += globToRegexp(strings.Trim(line, "/")) + "(/.*)?$"
+		if caseInsensitive {
+			expr = "(?i)" + expr
+		}
+
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			log.Debugf("ignoring pattern '%s': %s", line, err)
+			continue
+		}
+		p.re = re
+		m.patterns = append(m.patterns, p)
+		m.hasNegations = m.hasNegations || p.negate
+	}
+	return m
+}
+
+//ignored returns if the slash separated path, relative to the folder root, is ignored
+func (m *ignoreMatcher) ignored(rel string) bool {
+	for _, p := range m.patterns {
+		if p.re.MatchString(rel) {
+			return !p.negate
+		}
+	}
+	return false
+}
+
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				sb.WriteString(".*")
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			j := strings.IndexByte(glob[i:], ']')
+			if j < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+j]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += j
+		case '\\':
+			if i+1 < len(glob) {
+				sb.WriteString(regexp.QuoteMeta(string(glob[i+1])))
+				i++
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return sb.String()
+}
+
+func isSubPath(root, p string) bool {
+	rel, err := filepath.Rel(root, filepath.Clean(p))
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// translateOktetoIgnore translates gitignore patterns into syncthing ignore patterns.
+// Syncthing applies the first matching pattern while git applies the last one, so the order is reversed.
+func translateOktetoIgnore(lines []string) []string {
+	result := []string{}
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimRight(lines[i], " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		negate := false
+		if strings.HasPrefix(line, "!") {
+			negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, `\#`) {
+			line = line[1:]
+		}
+
+		line = strings.TrimSuffix(line, "/")
+		if line == "" {
+			continue
+		}
+
+		// a slash at the beginning or middle of a gitignore pattern makes it relative to the root folder
+		if !strings.HasPrefix(line, "/") && !strings.HasPrefix(line, "**/") && strings.Contains(line, "/") {
+			line = "/" + line
+		}
+
+		if negate {
+			line = "!" + line
+		}
+
+		result = append(result, line)
+	}
+	return result
+}
+
+// hasManagedIgnores returns if the syncthing ignores already contain the patterns managed by okteto
+func hasManagedIgnores(ignores []string) bool {
+	for _, line := range ignores {
+		if line == oktetoIgnoreBegin {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeIgnores replaces the '.oktetoignore' section of the syncthing ignores by the given patterns
+func mergeIgnores(ignores, oktetoIgnores []string) []string {
+	result := []string{}
+	if len(oktetoIgnores) > 0 {
+		result = append(result, oktetoIgnoreBegin)
+		result = append(result, oktetoIgnores...)
+		result = append(result, oktetoIgnoreEnd)
+	}
+
+	inSection := false
+	for _, line := range ignores {
+		switch {
+		case line == oktetoIgnoreBegin:
+			inSection = true
+		case line == oktetoIgnoreEnd:
+			inSection = false
+		case !inSection:
+			result = append(result, line)
+		}
+	}
+	return result
+}
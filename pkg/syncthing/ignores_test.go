@@ -0,0 +1,244 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncthing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/model"
+)
+
+func Test_translateOktetoIgnore(t *testing.T) {
+	var tests = []struct {
+		name     string
+		lines    []string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			lines:    []string{"", "# comment", "   "},
+			expected: []string{},
+		},
+		{
+			name:     "basic",
+			lines:    []string{"node_modules/", ".git", "/build"},
+			expected: []string{"/build", ".git", "node_modules"},
+		},
+		{
+			name:     "negation",
+			lines:    []string{"vendor", "!vendor/keep/this"},
+			expected: []string{"!/vendor/keep/this", "vendor"},
+		},
+		{
+			name:     "escaped",
+			lines:    []string{`\#file`},
+			expected: []string{"#file"},
+		},
+		{
+			name:     "double-star",
+			lines:    []string{"**/logs/*.log"},
+			expected: []string{"**/logs/*.log"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateOktetoIgnore(tt.lines)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("got %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_mergeIgnores(t *testing.T) {
+	var tests = []struct {
+		name          string
+		ignores       []string
+		oktetoIgnores []string
+		expected      []string
+	}{
+		{
+			name:          "no-oktetoignore",
+			ignores:       []string{".git"},
+			oktetoIgnores: nil,
+			expected:      []string{".git"},
+		},
+		{
+			name:          "add-section",
+			ignores:       []string{".git"},
+			oktetoIgnores: []string{"node_modules"},
+			expected:      []string{oktetoIgnoreBegin, "node_modules", oktetoIgnoreEnd, ".git"},
+		},
+		{
+			name:          "replace-section",
+			ignores:       []string{oktetoIgnoreBegin, "node_modules", oktetoIgnoreEnd, ".git"},
+			oktetoIgnores: []string{"vendor"},
+			expected:      []string{oktetoIgnoreBegin, "vendor", oktetoIgnoreEnd, ".git"},
+		},
+		{
+			name:          "remove-section",
+			ignores:       []string{oktetoIgnoreBegin, "node_modules", oktetoIgnoreEnd, ".git"},
+			oktetoIgnores: nil,
+			expected:      []string{".git"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeIgnores(tt.ignores, tt.oktetoIgnores)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("got %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_hasManagedIgnores(t *testing.T) {
+	if hasManagedIgnores([]string{".git", "node_modules"}) {
+		t.Error("ignores without the okteto block have managed ignores")
+	}
+	if !hasManagedIgnores([]string{oktetoIgnoreBegin, "vendor", oktetoIgnoreEnd, ".git"}) {
+		t.Error("ignores with the okteto block don't have managed ignores")
+	}
+}
+
+func Test_getSyncFoldersIgnores(t *testing.T) {
+	dev := &model.Dev{
+		MountPath: "/okteto",
+		Sync: model.SyncInfo{
+			Folders: []model.SyncFolder{
+				{LocalPath: "vendor/", RemotePath: "/okteto/vendor", ReadOnly: true},
+				{LocalPath: "src", RemotePath: "/usr/src/app"},
+				{LocalPath: "../lib", RemotePath: "/okteto/third_party/lib"},
+			},
+		},
+	}
+	expected := []string{"/vendor", "/src", "/third_party/lib"}
+	got := getSyncFoldersIgnores(dev)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func Test_getExternalSymlinksIgnores(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks are not synchronized on windows")
+	}
+
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "src")
+	for _, d := range []string{"shared", "node_modules", "build"} {
+		if err := os.MkdirAll(filepath.Join(root, d), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "shared", "config.yml"), []byte("key: value"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	links := map[string]string{
+		"config.yml":                         "shared/config.yml",
+		filepath.Join("shared", "self.yml"):  filepath.Join(root, "shared", "config.yml"),
+		"outside":                            "..",
+		filepath.Join("shared", "etc-hosts"): "/etc/hosts",
+		filepath.Join("node_modules", "lib"): "/etc/hosts",
+		filepath.Join("build", "hosts"):      "/etc/hosts",
+		"passwd":                             "/etc/passwd",
+	}
+	for link, target := range links {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	matcher := newIgnoreMatcher([]string{"// comment", "node_modules", "(?d)/build/**", "/passwd"})
+	expected := []string{"/outside", "/shared/etc-hosts"}
+	got := getExternalSymlinksIgnores(root, matcher)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func Test_ignoreMatcher(t *testing.T) {
+	var tests = []struct {
+		name     string
+		patterns []string
+		path     string
+		expected bool
+	}{
+		{name: "no-patterns", path: "src/main.go", expected: false},
+		{name: "name", patterns: []string{"node_modules"}, path: "web/node_modules", expected: true},
+		{name: "name-child", patterns: []string{"node_modules"}, path: "node_modules/lib/index.js", expected: true},
+		{name: "rooted", patterns: []string{"/build"}, path: "src/build", expected: false},
+		{name: "rooted-match", patterns: []string{"/build"}, path: "build/out", expected: true},
+		{name: "wildcard", patterns: []string{"*.log"}, path: "logs/app.log", expected: true},
+		{name: "wildcard-no-slash", patterns: []string{"/*.log"}, path: "logs/app.log", expected: false},
+		{name: "double-wildcard", patterns: []string{"/src/**/tmp"}, path: "src/a/b/tmp", expected: true},
+		{name: "prefixes", patterns: []string{"(?d)(?i)/Cache"}, path: "cache", expected: true},
+		{name: "negation-first", patterns: []string{"!/vendor/keep", "/vendor"}, path: "vendor/keep", expected: false},
+		{name: "negation-last", patterns: []string{"/vendor", "!/vendor/keep"}, path: "vendor/keep", expected: true},
+		{name: "class", patterns: []string{"file[0-9]"}, path: "file1", expected: true},
+		{name: "comments", patterns: []string{"// node_modules", "#include .stglobal"}, path: "node_modules", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newIgnoreMatcher(tt.patterns).ignored(tt.path); got != tt.expected {
+				t.Errorf("got %t, expected %t", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSyncthing_Folders(t *testing.T) {
+	dev := &model.Dev{
+		Name:      "api",
+		DevDir:    "/home/okteto/api",
+		MountPath: "/okteto",
+		Sync: model.SyncInfo{
+			Folders: []model.SyncFolder{
+				{LocalPath: "vendor", RemotePath: "/okteto/vendor", ReadOnly: true},
+				{LocalPath: "../lib", RemotePath: "/usr/src/lib"},
+			},
+		},
+	}
+	s := &Syncthing{Dev: dev}
+	got := s.Folders()
+	expected := []Folder{
+		{
+			ID:         "okteto-api-0",
+			LocalPath:  filepath.Join("/home/okteto/api", "vendor"),
+			RemotePath: "/okteto/vendor",
+			ReadOnly:   true,
+		},
+		{
+			ID:         "okteto-api-1",
+			LocalPath:  filepath.Join("/home/okteto", "lib"),
+			RemotePath: "/usr/src/lib",
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
@@ -16,6 +16,7 @@ package syncthing
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -25,6 +26,8 @@ import (
 
 	"github.com/Masterminds/semver"
 	getter "github.com/hashicorp/go-getter"
+	"github.com/okteto/okteto/pkg/config"
+	"github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
 )
@@ -37,6 +40,8 @@ var (
 		"windows": "https://github.com/syncthing/syncthing/releases/download/v1.5.0/syncthing-windows-amd64-v1.5.0.zip",
 	}
 
+	checksumsURL = "https://github.com/syncthing/syncthing/releases/download/v1.5.0/sha256sum.txt.asc"
+
 	minimumVersion = semver.MustParse("1.5.0")
 	versionRegex   = regexp.MustCompile(`syncthing v(\d+\.\d+\.\d+) .*`)
 )
@@ -45,11 +50,20 @@ var (
 func Install(p getter.ProgressTracker) error {
 	log.Debugf("installing syncthing for %s/%s", runtime.GOOS, runtime.GOARCH)
 
+	if err := CheckInstallPath(); err != nil {
+		return err
+	}
+
 	downloadURL, err := GetDownloadURL(runtime.GOOS, runtime.GOARCH)
 	if err != nil {
 		return err
 	}
 
+	checksum, err := getChecksum(downloadURL)
+	if err != nil {
+		return err
+	}
+
 	opts := []getter.ClientOption{}
 	if p != nil {
 		opts = []getter.ClientOption{getter.WithProgress(p)}
@@ -60,8 +74,9 @@ func Install(p getter.ProgressTracker) error {
 		return fmt.Errorf("failed to create temp download dir")
 	}
 
+	// go-getter verifies the checksum of the package before extracting it
 	client := &getter.Client{
-		Src:     downloadURL,
+		Src:     fmt.Sprintf("%s?checksum=sha256:%s", downloadURL, checksum),
 		Dst:     dir,
 		Mode:    getter.ClientModeDir,
 		Options: opts,
@@ -70,10 +85,13 @@ func Install(p getter.ProgressTracker) error {
 	defer os.RemoveAll(dir)
 
 	if err := client.Get(); err != nil {
-		return fmt.Errorf("failed to download syncthing from %s: %s", client.Src, err)
+		if strings.Contains(strings.ToLower(err.Error()), "checksums did not match") {
+			return fmt.Errorf("the syncthing package downloaded from %s is corrupted, please try again: %s", downloadURL, err)
+		}
+		return fmt.Errorf("failed to download syncthing from %s: %s", downloadURL, err)
 	}
 
-	log.Infof("downloaded syncthing from %s to %s", client.Src, dir)
+	log.Debugf("downloaded syncthing from %s to %s", downloadURL, dir)
 	i := getInstallPath()
 	b := getBinaryPathInDownload(dir, downloadURL)
 
@@ -86,17 +104,98 @@ func Install(p getter.ProgressTracker) error {
 		return fmt.Errorf("failed to set permissions to %s: %s", b, err)
 	}
 
-	if model.FileExists(i) {
-		if err := os.Remove(i); err != nil {
-			log.Infof("failed to delete %s, will try to overwrite: %s", i, err)
+	if err := installBinary(b, i); err != nil {
+		return err
+	}
+
+	log.Debugf("downloaded syncthing %s to %s", minimumVersion, i)
+	return nil
+}
+
+// installBinary copies the binary to a temporary file next to the install path and renames it,
+// so an interrupted copy never leaves a partial binary in the install path
+func installBinary(from, to string) error {
+	tmp := fmt.Sprintf("%s.download", to)
+	if err := os.Remove(tmp); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete %s: %s", tmp, err)
+	}
+	defer os.Remove(tmp)
+
+	if err := model.CopyFile(from, tmp); err != nil {
+		return fmt.Errorf("failed to write %s: %s", tmp, err)
+	}
+
+	if model.FileExists(to) {
+		if err := os.Remove(to); err != nil {
+			log.Debugf("failed to delete %s, will try to overwrite: %s", to, err)
 		}
 	}
 
-	if err := model.CopyFile(b, i); err != nil {
-		return fmt.Errorf("failed to write %s: %s", i, err)
+	if err := os.Rename(tmp, to); err != nil {
+		return fmt.Errorf("failed to write %s: %s", to, err)
 	}
 
-	log.Infof("downloaded syncthing %s to %s", versionRegex, i)
+	return nil
+}
+
+func getChecksum(downloadURL string) (string, error) {
+	resp, err := http.Get(checksumsURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to download the syncthing checksums from %s: %s", checksumsURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download the syncthing checksums from %s: status code %d", checksumsURL, resp.StatusCode)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the syncthing checksums from %s: %s", checksumsURL, err)
+	}
+
+	_, f := filepath.Split(downloadURL)
+	return parseChecksum(string(b), f)
+}
+
+// parseChecksum returns the checksum of the file in a sha256sum formatted content
+func parseChecksum(content, file string) (string, error) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		if strings.TrimPrefix(fields[1], "*") == file {
+			return fields[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("checksum of %s not found in %s", file, checksumsURL)
+}
+
+// CheckInstallPath returns an error if the folder where syncthing is installed is not writable
+func CheckInstallPath() error {
+	return checkWritable(config.GetSyncthingHome())
+}
+
+func checkWritable(dir string) error {
+	err := os.MkdirAll(dir, 0700)
+	if err == nil {
+		var f *os.File
+		f, err = ioutil.TempFile(dir, ".okteto-")
+		if err == nil {
+			f.Close()
+			err = os.Remove(f.Name())
+		}
+	}
+
+	if err != nil {
+		return errors.UserError{
+			E:    fmt.Errorf("'%s' is not writable: %s", dir, err),
+			Hint: "Set the OKTETO_HOME or XDG_DATA_HOME environment variables to a writable folder",
+		}
+	}
 	return nil
 }
 
@@ -14,6 +14,9 @@
 package syncthing
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -50,3 +53,101 @@ func Test_getBinaryPathInDownload(t *testing.T) {
 		t.Errorf("got %s, expected to finish with %s", p, getBinaryName())
 	}
 }
+
+func Test_parseChecksum(t *testing.T) {
+	content := `-----BEGIN PGP SIGNED MESSAGE-----
+Hash: SHA256
+
+0a1b2c  syncthing-linux-amd64-v1.5.0.tar.gz
+3d4e5f *syncthing-windows-amd64-v1.5.0.zip
+-----BEGIN PGP SIGNATURE-----`
+
+	var tests = []struct {
+		name      string
+		file      string
+		expected  string
+		expectErr bool
+	}{
+		{name: "found", file: "syncthing-linux-amd64-v1.5.0.tar.gz", expected: "0a1b2c"},
+		{name: "binary-mode", file: "syncthing-windows-amd64-v1.5.0.zip", expected: "3d4e5f"},
+		{name: "not-found", file: "syncthing-macos-amd64-v1.5.0.tar.gz", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChecksum(content, tt.file)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func Test_installBinary(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	from := filepath.Join(dir, "downloaded")
+	to := filepath.Join(dir, "syncthing")
+	if err := ioutil.WriteFile(from, []byte("new"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(to, []byte("old"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := installBinary(from, to); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "new" {
+		t.Errorf("expected 'new', got '%s'", string(b))
+	}
+
+	if _, err := os.Stat(to + ".download"); !os.IsNotExist(err) {
+		t.Errorf("temporary file was not removed: %v", err)
+	}
+}
+
+func Test_checkWritable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := checkWritable(filepath.Join(dir, "okteto")); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	file := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(file, []byte("okteto"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	err = checkWritable(filepath.Join(file, "okteto"))
+	if err == nil {
+		t.Fatal("expected error for a path that can't be created")
+	}
+	if !strings.Contains(err.Error(), file) {
+		t.Errorf("error doesn't name the path: %s", err)
+	}
+}
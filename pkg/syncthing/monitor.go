@@ -15,13 +15,32 @@ package syncthing
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/log"
 )
 
+const conflictMarker = ".sync-conflict-"
+
+// ChangeEvent represents a LocalChangeDetected or RemoteChangeDetected syncthing event.
+type ChangeEvent struct {
+	ID   int64           `json:"id"`
+	Type string          `json:"type"`
+	Data ChangeEventData `json:"data"`
+}
+
+// ChangeEventData represents the data of a syncthing change event.
+type ChangeEventData struct {
+	Action   string `json:"action"`
+	FolderID string `json:"folderID"`
+	Path     string `json:"path"`
+}
+
 func (s *Syncthing) checkLocalAndRemoteStatus(ctx context.Context) error {
 	if err := s.checkStatus(ctx, true); err != nil {
 		return err
@@ -45,20 +64,101 @@ func (s *Syncthing) checkStatus(ctx context.Context, local bool) error {
 	return fmt.Errorf("error getting folder errors from local=%t: %s", local, err)
 }
 
+// GetConflicts returns the conflict files detected by the local syncthing since the last call
+func (s *Syncthing) GetConflicts(ctx context.Context) ([]string, error) {
+	params := map[string]string{
+		"since":   strconv.FormatInt(s.lastEventID, 10),
+		"timeout": "1",
+		"events":  "LocalChangeDetected,RemoteChangeDetected",
+	}
+	body, err := s.APICall(ctx, "rest/events", "GET", 200, params, true, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []ChangeEvent{}
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, err
+	}
+
+	return s.processChangeEvents(events), nil
+}
+
+//processChangeEvents returns the conflict files of events that haven't been reported before
+func (s *Syncthing) processChangeEvents(events []ChangeEvent) []string {
+	s.conflictsMu.Lock()
+	defer s.conflictsMu.Unlock()
+
+	result := []string{}
+	for _, e := range events {
+		if e.ID > s.lastEventID {
+			s.lastEventID = e.ID
+		}
+
+		if e.Data.Action == "deleted" || !strings.Contains(e.Data.Path, conflictMarker) {
+			continue
+		}
+
+		if s.isConflictReported(e.Data.Path) {
+			continue
+		}
+
+		s.conflicts = append(s.conflicts, e.Data.Path)
+		result = append(result, e.Data.Path)
+	}
+
+	return result
+}
+
+func (s *Syncthing) isConflictReported(path string) bool {
+	for _, c := range s.conflicts {
+		if c == path {
+			return true
+		}
+	}
+	return false
+}
+
+// Conflicts returns all the conflict files detected during the session
+func (s *Syncthing) Conflicts() []string {
+	s.conflictsMu.Lock()
+	defer s.conflictsMu.Unlock()
+	return append([]string{}, s.conflicts...)
+}
+
+func (s *Syncthing) checkConflicts(ctx context.Context, warnings chan error) {
+	conflicts, err := s.GetConflicts(ctx)
+	if err != nil {
+		log.Debugf("error getting syncthing conflicts: %s", err)
+		return
+	}
+
+	for _, c := range conflicts {
+		log.Debugf("syncthing conflict detected: %s", c)
+		select {
+		case warnings <- fmt.Errorf("Synchronization conflict detected, both copies were modified: %s", c):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Monitor will send a message to disconnected if remote syncthing is disconnected for more than 10 seconds.
-func (s *Syncthing) Monitor(ctx context.Context, disconnect chan error) {
+// Synchronization conflicts are sent to warnings.
+func (s *Syncthing) Monitor(ctx context.Context, disconnect, warnings chan error) {
 	ticker := time.NewTicker(20 * time.Second)
 	retries := 0
 	for {
 		select {
 		case <-ticker.C:
+			s.checkConflicts(ctx, warnings)
 			err := s.checkLocalAndRemoteStatus(ctx)
 			if err == nil {
 				retries = 0
 				continue
 			}
 			if retries >= 3 {
-				log.Infof("syncthing not connected, sending disconnect signal: %s", err)
+				log.Debugf("syncthing not connected, sending disconnect signal: %s", err)
 				disconnect <- errors.ErrLostSyncthing
 				return
 			}
@@ -0,0 +1,54 @@
+// Copyright 2020 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncthing
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProcessChangeEvents(t *testing.T) {
+	s := &Syncthing{}
+	events := []ChangeEvent{
+		{ID: 1, Type: "LocalChangeDetected", Data: ChangeEventData{Action: "modified", Path: "main.go"}},
+		{ID: 2, Type: "RemoteChangeDetected", Data: ChangeEventData{Action: "added", Path: "main.sync-conflict-20200101-120000-ABKAVQF.go"}},
+		{ID: 3, Type: "LocalChangeDetected", Data: ChangeEventData{Action: "deleted", Path: "old.sync-conflict-20200101-120000-ABKAVQF.go"}},
+	}
+
+	got := s.processChangeEvents(events)
+	expected := []string{"main.sync-conflict-20200101-120000-ABKAVQF.go"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if s.lastEventID != 3 {
+		t.Errorf("expected last event id 3, got %d", s.lastEventID)
+	}
+
+	events = []ChangeEvent{
+		{ID: 4, Type: "LocalChangeDetected", Data: ChangeEventData{Action: "modified", Path: "main.sync-conflict-20200101-120000-ABKAVQF.go"}},
+		{ID: 5, Type: "LocalChangeDetected", Data: ChangeEventData{Action: "added", Path: "index.sync-conflict-20200101-130000-ATOPHFJ.html"}},
+	}
+
+	got = s.processChangeEvents(events)
+	expected = []string{"index.sync-conflict-20200101-130000-ATOPHFJ.html"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	expected = []string{"main.sync-conflict-20200101-120000-ABKAVQF.go", "index.sync-conflict-20200101-130000-ATOPHFJ.html"}
+	if !reflect.DeepEqual(s.Conflicts(), expected) {
+		t.Errorf("expected %v, got %v", expected, s.Conflicts())
+	}
+}
@@ -23,10 +23,12 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
@@ -52,6 +54,9 @@ const (
 	logFile          = "syncthing.log"
 	syncthingPidFile = "syncthing.pid"
 
+	// terminateTimeout is how long to wait for syncthing to exit before killing it
+	terminateTimeout = 5 * time.Second
+
 	// DefaultRemoteDeviceID remote syncthing ID
 	DefaultRemoteDeviceID = "ATOPHFJ-VPVLDFY-QVZDCF2-OQQ7IOW-OG4DIXF-OA7RWU3-ZYA4S22-SI4XVAU"
 	localDeviceID         = "ABKAVQF-RUO4CYO-FSC2VIP-VRX4QDA-TQQRN2J-MRDXJUC-FXNWP6N-S6ZSAAR"
@@ -91,6 +96,17 @@ type Syncthing struct {
 	Type             string       `yaml:"-"`
 	IgnoreDelete     bool         `yaml:"-"`
 	pid              int          `yaml:"-"`
+	lastEventID      int64        `yaml:"-"`
+	conflicts        []string     `yaml:"-"`
+	conflictsMu      sync.Mutex   `yaml:"-"`
+}
+
+//Folder represents an additional synchronized folder
+type Folder struct {
+	ID         string
+	LocalPath  string
+	RemotePath string
+	ReadOnly   bool
 }
 
 //Ignores represents the .stignore file
@@ -110,6 +126,17 @@ type Completion struct {
 	GlobalBytes int64   `json:"globalBytes"`
 	NeedBytes   int64   `json:"needBytes"`
 	NeedDeletes int64   `json:"needDeletes"`
+
+	// Folder is the local path of an additional synchronized folder, empty for the main folder
+	Folder string `json:"-"`
+}
+
+// Progress returns the percentage of bytes synchronized
+func (c *Completion) Progress() float64 {
+	if c.GlobalBytes == 0 {
+		return 100
+	}
+	return (float64(c.GlobalBytes-c.NeedBytes) / float64(c.GlobalBytes)) * 100
 }
 
 // FolderErrors represents folder errors in syncthing.
@@ -128,33 +155,41 @@ type FolderError struct {
 	Path  string `json:"path"`
 }
 
-// New constructs a new Syncthing.
-func New(dev *model.Dev) (*Syncthing, error) {
-	fullPath := getInstallPath()
-	remotePort, err := model.GetAvailablePort()
-	if err != nil {
-		return nil, err
-	}
+// Connections represents the connections of a syncthing instance.
+type Connections struct {
+	Connections map[string]Connection `json:"connections"`
+}
 
-	remoteGUIPort, err := model.GetAvailablePort()
-	if err != nil {
-		return nil, err
-	}
+// Connection represents the connection of a syncthing instance to a device.
+type Connection struct {
+	Connected bool `json:"connected"`
+}
 
-	guiPort, err := model.GetAvailablePort()
-	if err != nil {
-		return nil, err
-	}
+// FolderStats represents the statistics of a syncthing folder.
+type FolderStats struct {
+	LastFile LastFile  `json:"lastFile"`
+	LastScan time.Time `json:"lastScan"`
+}
+
+// LastFile represents the last file synchronized in a syncthing folder.
+type LastFile struct {
+	At       time.Time `json:"at"`
+	Filename string    `json:"filename"`
+}
 
-	listenPort, err := model.GetAvailablePort()
+// New constructs a new Syncthing.
+func New(dev *model.Dev) (*Syncthing, error) {
+	fullPath := getInstallPath()
+	ports, err := model.GetAvailablePorts(4, getReservedPorts(dev))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to select the local ports of the file synchronization service: %s", err)
 	}
+	remotePort, remoteGUIPort, guiPort, listenPort := ports[0], ports[1], ports[2], ports[3]
 
 	pwd := uuid.New().String()
 	hash, err := bcrypt.GenerateFromPassword([]byte(pwd), 0)
 	if err != nil {
-		log.Infof("couldn't hash the password %s", err)
+		log.Debugf("couldn't hash the password %s", err)
 		hash = []byte("")
 	}
 
@@ -168,7 +203,7 @@ func New(dev *model.Dev) (*Syncthing, error) {
 		DevPath:          dev.DevPath,
 		FileWatcherDelay: DefaultFileWatcherDelay,
 		GUIAddress:       fmt.Sprintf("localhost:%d", guiPort),
-		Home:             config.GetDeploymentHome(dev.Namespace, dev.Name),
+		Home:             config.GetSyncthingDeploymentHome(dev.Namespace, dev.Name),
 		LogPath:          config.GetSyncthingLogFile(dev.Namespace, dev.Name),
 		ListenAddress:    fmt.Sprintf("localhost:%d", listenPort),
 		RemoteAddress:    fmt.Sprintf("tcp://localhost:%d", remotePort),
@@ -182,14 +217,29 @@ func New(dev *model.Dev) (*Syncthing, error) {
 	}
 
 	if err := s.Save(dev); err != nil {
-		log.Infof("error saving syncthing object: %s", err)
+		log.Debugf("error saving syncthing object: %s", err)
 	}
 
-	log.Infof("local syncthing intialized: gui -> %d, sync -> %d", guiPort, listenPort)
-	log.Infof("remote syncthing intialized: gui -> %d, sync -> %d", remoteGUIPort, remotePort)
+	log.Debugf("local syncthing intialized: gui -> %d, sync -> %d", guiPort, listenPort)
+	log.Debugf("remote syncthing intialized: gui -> %d, sync -> %d", remoteGUIPort, remotePort)
 	return s, nil
 }
 
+// getReservedPorts returns the local ports used by the okteto manifest, which can't be used by syncthing
+func getReservedPorts(dev *model.Dev) []int {
+	reserved := []int{}
+	if dev.RemotePort != 0 {
+		reserved = append(reserved, dev.RemotePort)
+	}
+	for _, f := range dev.Forward {
+		reserved = append(reserved, f.Local)
+	}
+	for _, r := range dev.Reverse {
+		reserved = append(reserved, r.Local)
+	}
+	return reserved
+}
+
 func (s *Syncthing) cleanupDaemon(pid int) error {
 	process, err := ps.FindProcess(pid)
 	if process == nil && err == nil {
@@ -197,7 +247,7 @@ func (s *Syncthing) cleanupDaemon(pid int) error {
 	}
 
 	if err != nil {
-		log.Infof("error when looking up the process: %s", err)
+		log.Debugf("error when looking up the process: %s", err)
 		return err
 	}
 
@@ -206,12 +256,36 @@ func (s *Syncthing) cleanupDaemon(pid int) error {
 		return nil
 	}
 
-	err = terminate(pid)
-	if err == nil {
-		log.Infof("terminated syncthing with pid %d", pid)
+	if err := terminate(pid); err != nil {
+		return err
+	}
+
+	if !waitForProcessExit(pid, terminateTimeout) {
+		log.Debugf("syncthing pid-%d didn't exit after %s, killing it", pid, terminateTimeout)
+		if p, err := os.FindProcess(pid); err == nil {
+			if err := p.Kill(); err != nil && !strings.Contains(err.Error(), "process already finished") {
+				return err
+			}
+		}
 	}
 
-	return err
+	log.Debugf("terminated syncthing with pid %d", pid)
+	return nil
+}
+
+// waitForProcessExit returns true if the process exits before the timeout
+func waitForProcessExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		process, err := ps.FindProcess(pid)
+		if err == nil && process == nil {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
 }
 
 func (s *Syncthing) initConfig() error {
@@ -255,6 +329,15 @@ func (s *Syncthing) Run(ctx context.Context) error {
 
 	pidPath := filepath.Join(s.Home, syncthingPidFile)
 
+	// a previous session that didn't shut down cleanly leaves its syncthing running and its pidfile behind.
+	// Only the process in okteto's pidfile is terminated, never other syncthing installs
+	if pid, err := getPID(pidPath); err == nil && pid != s.pid {
+		log.Debugf("cleaning up stale syncthing pid-%d from a previous session", pid)
+		if err := s.Stop(true); err != nil {
+			return fmt.Errorf("failed to stop the stale syncthing process pid-%d: %s", pid, err)
+		}
+	}
+
 	cmdArgs := []string{
 		"-home", s.Home,
 		"-no-browser",
@@ -283,14 +366,20 @@ func (s *Syncthing) Run(ctx context.Context) error {
 
 	s.pid = s.cmd.Process.Pid
 
-	log.Infof("local syncthing pid-%d running", s.pid)
+	log.Debugf("local syncthing pid-%d running", s.pid)
 	return nil
 }
 
+//Ping checks that syncthing is responding
+func (s *Syncthing) Ping(ctx context.Context, local bool) error {
+	_, err := s.APICall(ctx, "rest/system/ping", "GET", 200, nil, local, nil)
+	return err
+}
+
 //WaitForPing waits for synthing to be ready
 func (s *Syncthing) WaitForPing(ctx context.Context, local bool) error {
 	ticker := time.NewTicker(100 * time.Millisecond)
-	log.Infof("waiting for syncthing local=%t to be ready...", local)
+	log.Debugf("waiting for syncthing local=%t to be ready...", local)
 	for i := 0; i < 150; i++ {
 		_, err := s.APICall(ctx, "rest/system/ping", "GET", 200, nil, local, nil)
 		if err == nil {
@@ -308,19 +397,23 @@ func (s *Syncthing) WaitForPing(ctx context.Context, local bool) error {
 	return fmt.Errorf("Syncthing local=%t not responding after 15s", local)
 }
 
-//SendStignoreFile sends .stignore from local to remote
+//SendStignoreFile sends .stignore from local to remote, including the patterns defined in .oktetoignore
 func (s *Syncthing) SendStignoreFile(ctx context.Context, dev *model.Dev) {
-	log.Infof("sending '.stignore' file to the remote syncthing...")
+	if err := s.updateLocalIgnores(ctx, dev); err != nil {
+		log.Debugf("error updating local ignores with '%s': %s", oktetoIgnoreFile, err)
+	}
+
+	log.Debugf("sending '.stignore' file to the remote syncthing...")
 	params := getFolderParameter(dev)
 	ignores := &Ignores{}
 	body, err := s.APICall(ctx, "rest/db/ignores", "GET", 200, params, true, nil)
 	if err != nil {
-		log.Infof("error getting 'rest/db/ignores' syncthing API: %s", err)
+		log.Debugf("error getting 'rest/db/ignores' syncthing API: %s", err)
 		return
 	}
 	err = json.Unmarshal(body, ignores)
 	if err != nil {
-		log.Infof("error unmarshaling 'rest/db/ignores': %s", err)
+		log.Debugf("error unmarshaling 'rest/db/ignores': %s", err)
 		return
 	}
 	for i, line := range ignores.Ignore {
@@ -335,22 +428,22 @@ func (s *Syncthing) SendStignoreFile(ctx context.Context, dev *model.Dev) {
 	}
 	body, err = json.Marshal(ignores)
 	if err != nil {
-		log.Infof("error marshaling 'rest/db/ignores': %s", err)
+		log.Debugf("error marshaling 'rest/db/ignores': %s", err)
 	}
 	_, err = s.APICall(ctx, "rest/db/ignores", "POST", 200, params, false, body)
 	if err != nil {
-		log.Infof("error posting 'rest/db/ignores' syncthing API: %s", err)
+		log.Debugf("error posting 'rest/db/ignores' syncthing API: %s", err)
 		return
 	}
 }
 
 //ResetDatabase resets the syncthing database
 func (s *Syncthing) ResetDatabase(ctx context.Context, dev *model.Dev, local bool) error {
-	log.Infof("reseting syncthing database local=%t...", local)
+	log.Debugf("reseting syncthing database local=%t...", local)
 	params := getFolderParameter(dev)
 	_, err := s.APICall(ctx, "rest/system/reset", "POST", 200, params, local, nil)
 	if err != nil {
-		log.Infof("error posting 'rest/system/reset' local=%t syncthing API: %s", local, err)
+		log.Debugf("error posting 'rest/system/reset' local=%t syncthing API: %s", local, err)
 		return err
 	}
 	return nil
@@ -358,11 +451,15 @@ func (s *Syncthing) ResetDatabase(ctx context.Context, dev *model.Dev, local boo
 
 //Overwrite overwrites local changes to the remote syncthing
 func (s *Syncthing) Overwrite(ctx context.Context, dev *model.Dev) error {
-	log.Infof("overriding local changes to the remote syncthing...")
-	params := getFolderParameter(dev)
+	return s.overwriteFolder(ctx, getFolderParameter(dev)["folder"])
+}
+
+func (s *Syncthing) overwriteFolder(ctx context.Context, folder string) error {
+	log.Debugf("overriding local changes of folder '%s' to the remote syncthing...", folder)
+	params := getFolderIDParameter(folder)
 	_, err := s.APICall(ctx, "rest/db/override", "POST", 200, params, true, nil)
 	if err != nil {
-		log.Infof("error posting 'rest/db/override' syncthing API: %s", err)
+		log.Debugf("error posting 'rest/db/override' syncthing API: %s", err)
 		return err
 	}
 	return nil
@@ -373,7 +470,7 @@ func (s *Syncthing) WaitForScanning(ctx context.Context, dev *model.Dev, local b
 	ticker := time.NewTicker(100 * time.Millisecond)
 	params := getFolderParameter(dev)
 	status := &Status{}
-	log.Infof("waiting for initial scan to complete local=%t...", local)
+	log.Debugf("waiting for initial scan to complete local=%t...", local)
 	for i := 0; i < 3000; i++ {
 		select {
 		case <-ticker.C:
@@ -395,7 +492,7 @@ func (s *Syncthing) WaitForScanning(ctx context.Context, dev *model.Dev, local b
 
 		if i%100 == 0 {
 			// one log every 10 seconds
-			log.Infof("syncthing folder local=%t is '%s'", local, status.State)
+			log.Debugf("syncthing folder local=%t is '%s'", local, status.State)
 		}
 
 		if status.State != "scanning" && status.State != "scan-waiting" {
@@ -405,21 +502,45 @@ func (s *Syncthing) WaitForScanning(ctx context.Context, dev *model.Dev, local b
 	return fmt.Errorf("Syncthing not completed initial scan after 5min. Please, retry in a few minutes")
 }
 
-// WaitForCompletion waits for the remote to be totally synched
-func (s *Syncthing) WaitForCompletion(ctx context.Context, dev *model.Dev, reporter chan float64) error {
+// WaitForCompletion waits for the initial synchronization of the main folder, up to the start threshold of the manifest,
+// and of the additional folders
+func (s *Syncthing) WaitForCompletion(ctx context.Context, dev *model.Dev, reporter chan *Completion) error {
 	defer close(reporter)
+	log.Debugf("waiting for synchronization to complete...")
+	if err := s.waitForFolderCompletion(ctx, getFolderParameter(dev)["folder"], "", float64(dev.Sync.StartThreshold), reporter); err != nil {
+		return err
+	}
+
+	for _, f := range s.Folders() {
+		if err := s.waitForFolderCompletion(ctx, f.ID, f.LocalPath, 100, reporter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitForMainFolderCompletion waits until the initial synchronization of the main folder finishes
+func (s *Syncthing) WaitForMainFolderCompletion(ctx context.Context, dev *model.Dev) error {
+	return s.waitForFolderCompletion(ctx, getFolderParameter(dev)["folder"], "", 100, nil)
+}
+
+//waitForFolderCompletion overrides the remote changes of a folder until its synchronization reaches threshold percent.
+//label is the local path reported in the completion of an additional folder, empty for the main folder.
+//reporter is optional
+func (s *Syncthing) waitForFolderCompletion(ctx context.Context, folder, label string, threshold float64, reporter chan *Completion) error {
 	ticker := time.NewTicker(500 * time.Millisecond)
-	log.Infof("waiting for synchronization to complete...")
+	defer ticker.Stop()
+	log.Debugf("waiting for synchronization of folder '%s' to complete...", folder)
 	retries := 0
 	for {
 		select {
 		case <-ticker.C:
-			if err := s.Overwrite(ctx, dev); err != nil {
-				log.Infof("error calling 'rest/db/override' syncthing API: %s", err)
+			if err := s.overwriteFolder(ctx, folder); err != nil {
+				log.Debugf("error calling 'rest/db/override' syncthing API: %s", err)
 				continue
 			}
 
-			completion, err := s.GetCompletion(ctx, dev, true)
+			completion, err := s.getFolderCompletion(ctx, folder, true)
 			if err != nil {
 				log.Debugf("error calling getting completion: %s", err)
 				continue
@@ -429,27 +550,35 @@ func (s *Syncthing) WaitForCompletion(ctx context.Context, dev *model.Dev, repor
 				return nil
 			}
 
-			progress := (float64(completion.GlobalBytes-completion.NeedBytes) / float64(completion.GlobalBytes)) * 100
-			log.Infof("syncthing folder is %.2f%%, needBytes %d, needDeletes %d",
+			progress := completion.Progress()
+			log.Debugf("syncthing folder '%s' is %.2f%%, needBytes %d, needDeletes %d",
+				folder,
 				progress,
 				completion.NeedBytes,
 				completion.NeedDeletes,
 			)
 
-			reporter <- progress
+			if reporter != nil {
+				completion.Folder = label
+				reporter <- completion
+			}
 
 			if completion.NeedBytes == 0 {
 				return nil
 			}
 
-			status, err := s.GetStatus(ctx, dev, false)
+			if threshold < 100 && progress >= threshold {
+				log.Debugf("syncthing folder '%s' reached the start threshold of %.0f%%", folder, threshold)
+				return nil
+			}
+
+			status, err := s.getFolderStatus(ctx, folder, false)
 			if err != nil {
 				log.Debugf("error getting status: %s", err)
 				continue
-
 			}
 			if status.PullErrors > 0 {
-				if err := s.GetFolderErrors(ctx, dev, false); err != nil {
+				if err := s.getFolderErrors(ctx, folder, false); err != nil {
 					return err
 				}
 				retries++
@@ -468,7 +597,11 @@ func (s *Syncthing) WaitForCompletion(ctx context.Context, dev *model.Dev, repor
 
 // GetStatus returns the syncthing status
 func (s *Syncthing) GetStatus(ctx context.Context, dev *model.Dev, local bool) (*Status, error) {
-	params := getFolderParameter(dev)
+	return s.getFolderStatus(ctx, getFolderParameter(dev)["folder"], local)
+}
+
+func (s *Syncthing) getFolderStatus(ctx context.Context, folder string, local bool) (*Status, error) {
+	params := getFolderIDParameter(folder)
 	status := &Status{}
 	body, err := s.APICall(ctx, "rest/db/status", "GET", 200, params, local, nil)
 	if err != nil {
@@ -484,7 +617,11 @@ func (s *Syncthing) GetStatus(ctx context.Context, dev *model.Dev, local bool) (
 
 // GetCompletion returns the syncthing completion
 func (s *Syncthing) GetCompletion(ctx context.Context, dev *model.Dev, local bool) (*Completion, error) {
-	params := getFolderParameter(dev)
+	return s.getFolderCompletion(ctx, getFolderParameter(dev)["folder"], local)
+}
+
+func (s *Syncthing) getFolderCompletion(ctx context.Context, folder string, local bool) (*Completion, error) {
+	params := getFolderIDParameter(folder)
 	if local {
 		params["device"] = DefaultRemoteDeviceID
 	} else {
@@ -509,16 +646,60 @@ func (s *Syncthing) GetCompletionProgress(ctx context.Context, dev *model.Dev, l
 	if err != nil {
 		return 0, err
 	}
-	if completion.GlobalBytes == 0 {
-		return 100, nil
+	return completion.Progress(), nil
+}
+
+// IsConnected returns true if the local syncthing is connected to the remote syncthing
+func (s *Syncthing) IsConnected(ctx context.Context) bool {
+	connections := &Connections{}
+	body, err := s.APICall(ctx, "rest/system/connections", "GET", 200, nil, true, nil)
+	if err != nil {
+		log.Debugf("error calling 'rest/system/connections' syncthing API: %s", err)
+		return false
+	}
+	if err := json.Unmarshal(body, connections); err != nil {
+		log.Debugf("error unmarshaling 'rest/system/connections': %s", err)
+		return false
+	}
+
+	remote, ok := connections.Connections[DefaultRemoteDeviceID]
+	if !ok {
+		return false
+	}
+	return remote.Connected
+}
+
+// GetLastSync returns the time of the last file synchronized to the remote syncthing
+func (s *Syncthing) GetLastSync(ctx context.Context, dev *model.Dev) (time.Time, error) {
+	folder := getFolderParameter(dev)["folder"]
+	stats := map[string]FolderStats{}
+	body, err := s.APICall(ctx, "rest/stats/folder", "GET", 200, nil, false, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := json.Unmarshal(body, &stats); err != nil {
+		return time.Time{}, err
+	}
+
+	folderStats, ok := stats[folder]
+	if !ok {
+		return time.Time{}, fmt.Errorf("folder '%s' not found in syncthing stats", folder)
 	}
-	progress := (float64(completion.GlobalBytes-completion.NeedBytes) / float64(completion.GlobalBytes)) * 100
-	return progress, nil
+
+	if folderStats.LastFile.At.IsZero() {
+		return folderStats.LastScan, nil
+	}
+
+	return folderStats.LastFile.At, nil
 }
 
 // GetFolderErrors returns the last folder errors
 func (s *Syncthing) GetFolderErrors(ctx context.Context, dev *model.Dev, local bool) error {
-	params := getFolderParameter(dev)
+	return s.getFolderErrors(ctx, getFolderParameter(dev)["folder"], local)
+}
+
+func (s *Syncthing) getFolderErrors(ctx context.Context, folder string, local bool) error {
+	params := getFolderIDParameter(folder)
 	params["since"] = "0"
 	params["limit"] = "1"
 	params["timeout"] = "15"
@@ -534,27 +715,31 @@ func (s *Syncthing) GetFolderErrors(ctx context.Context, dev *model.Dev, local b
 	}
 
 	if len(folderErrorsList) == 0 {
-		log.Infof("ignoring syncthing unknown error local=%t: empty folderErrorsList", local)
+		log.Debugf("ignoring syncthing unknown error local=%t: empty folderErrorsList", local)
 		return nil
 	}
 	folderErrors := folderErrorsList[len(folderErrorsList)-1]
 	if len(folderErrors.Data.Errors) == 0 {
-		log.Infof("ignoring syncthing unknown error local=%t: empty folderErrors.Data.Errors", local)
+		log.Debugf("ignoring syncthing unknown error local=%t: empty folderErrors.Data.Errors", local)
 		return nil
 	}
 
 	errMsg := folderErrors.Data.Errors[0].Error
 	if strings.Contains(errMsg, "too many open files") {
-		log.Infof("ignoring syncthing 'too many open files' error local=%t: %s", local, errMsg)
+		log.Debugf("ignoring syncthing 'too many open files' error local=%t: %s", local, errMsg)
 		return nil
 	}
 
 	return fmt.Errorf("%s: %s", folderErrors.Data.Errors[0].Path, errMsg)
 }
 
-// Restart restarts the syncthing process
+// Restart restarts the syncthing process, reloading the .oktetoignore patterns
 func (s *Syncthing) Restart(ctx context.Context) error {
-	log.Infof("restarting syncthing...")
+	if s.Dev != nil {
+		s.SendStignoreFile(ctx, s.Dev)
+	}
+
+	log.Debugf("restarting syncthing...")
 	_, err := s.APICall(ctx, "rest/system/restart", "POST", 200, nil, true, nil)
 	return err
 }
@@ -569,7 +754,7 @@ func (s *Syncthing) Stop(force bool) error {
 
 	if !force {
 		if pid != s.pid {
-			log.Infof("syncthing pid-%d wasn't created by this command, skipping", pid)
+			log.Debugf("syncthing pid-%d wasn't created by this command, skipping", pid)
 			return nil
 		}
 	}
@@ -583,7 +768,7 @@ func (s *Syncthing) Stop(force bool) error {
 			return nil
 		}
 
-		log.Infof("failed to delete pidfile %s: %s", pidPath, err)
+		log.Debugf("failed to delete pidfile %s: %s", pidPath, err)
 	}
 
 	return nil
@@ -629,18 +814,23 @@ func RemoveFolder(dev *model.Dev) error {
 		return fmt.Errorf("failed to create syncthing instance")
 	}
 
+	return s.RemoveHome()
+}
+
+// RemoveHome deletes the home folder of the syncthing instance, including its database and configuration
+func (s *Syncthing) RemoveHome() error {
 	if s.Home == "" {
-		log.Info("the home directory is not set when deleting")
+		log.Debug("the home directory is not set when deleting")
 		return nil
 	}
 
-	if _, err := filepath.Rel(config.GetOktetoHome(), s.Home); err != nil || config.GetOktetoHome() == s.Home {
-		log.Errorf("%s is not inside %s, ignoring", s.Home, config.GetOktetoHome())
+	if _, err := filepath.Rel(config.GetSyncthingHome(), s.Home); err != nil || config.GetSyncthingHome() == s.Home {
+		log.Errorf("%s is not inside %s, ignoring", s.Home, config.GetSyncthingHome())
 		return nil
 	}
 
 	if err := os.RemoveAll(s.Home); err != nil {
-		log.Info(err)
+		log.Debug(err)
 		return nil
 	}
 
@@ -648,14 +838,14 @@ func RemoveFolder(dev *model.Dev) error {
 	if parentDir != "." {
 		empty, err := isDirEmpty(parentDir)
 		if err != nil {
-			log.Info(err)
+			log.Debug(err)
 			return nil
 		}
 
 		if empty {
 			log.Debugf("deleting %s since it's empty", parentDir)
 			if err := os.RemoveAll(parentDir); err != nil {
-				log.Infof("couldn't delete folder: %s", err)
+				log.Debugf("couldn't delete folder: %s", err)
 				return nil
 			}
 		}
@@ -692,7 +882,7 @@ func getPID(pidPath string) (int, error) {
 }
 
 func getInstallPath() string {
-	return filepath.Join(config.GetOktetoHome(), getBinaryName())
+	return filepath.Join(config.GetSyncthingHome(), getBinaryName())
 }
 
 func getBinaryName() string {
@@ -703,7 +893,31 @@ func getBinaryName() string {
 	return "syncthing"
 }
 
+//Folders returns the additional folders synchronized between the local and the remote syncthing
+func (s *Syncthing) Folders() []Folder {
+	result := []Folder{}
+	if s.Dev == nil {
+		return result
+	}
+	for i, f := range s.Dev.Sync.Folders {
+		localPath := filepath.FromSlash(f.LocalPath)
+		if !filepath.IsAbs(localPath) {
+			localPath = filepath.Join(s.Dev.DevDir, localPath)
+		}
+		result = append(result, Folder{
+			ID:         fmt.Sprintf("okteto-%s-%d", s.Dev.Name, i),
+			LocalPath:  localPath,
+			RemotePath: path.Clean(f.RemotePath),
+			ReadOnly:   f.ReadOnly,
+		})
+	}
+	return result
+}
+
 func getFolderParameter(dev *model.Dev) map[string]string {
-	folder := fmt.Sprintf("okteto-%s", dev.Name)
+	return getFolderIDParameter(fmt.Sprintf("okteto-%s", dev.Name))
+}
+
+func getFolderIDParameter(folder string) map[string]string {
 	return map[string]string{"folder": folder, "device": DefaultRemoteDeviceID}
 }